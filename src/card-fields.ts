@@ -0,0 +1,68 @@
+/**
+ * Card fields accepted by Trello's `fields` / `card_fields` query parameters.
+ */
+export const TRELLO_CARD_FIELDS: ReadonlySet<string> = new Set([
+  'all',
+  'id',
+  'address',
+  'badges',
+  'checkItemStates',
+  'closed',
+  'coordinates',
+  'cover',
+  'creationMethod',
+  'dateLastActivity',
+  'desc',
+  'descData',
+  'due',
+  'dueComplete',
+  'dueReminder',
+  'email',
+  'idAttachmentCover',
+  'idBoard',
+  'idChecklists',
+  'idLabels',
+  'idList',
+  'idMembers',
+  'idMembersVoted',
+  'idShort',
+  'isTemplate',
+  'labels',
+  'limits',
+  'locationName',
+  'manualCoverAttachment',
+  'name',
+  'pos',
+  'shortLink',
+  'shortUrl',
+  'start',
+  'subscribed',
+  'url',
+]);
+
+/**
+ * Normalize the `defaultFields` config section (tool name -> fields) into
+ * comma-separated strings, rejecting unknown card field names.
+ */
+export function parseDefaultFields(section: unknown): Record<string, string> {
+  if (typeof section !== 'object' || section === null || Array.isArray(section)) {
+    throw new Error('defaultFields must be an object mapping tool names to card fields');
+  }
+
+  const result: Record<string, string> = {};
+  for (const [toolName, value] of Object.entries(section)) {
+    const fields = (Array.isArray(value) ? value : String(value).split(','))
+      .map(field => String(field).trim())
+      .filter(field => field.length > 0);
+    const unknown = fields.filter(field => !TRELLO_CARD_FIELDS.has(field));
+    if (unknown.length > 0) {
+      throw new Error(
+        `defaultFields.${toolName} contains unknown card fields: ${unknown.join(', ')}`
+      );
+    }
+    if (fields.length > 0) {
+      result[toolName] = fields.join(',');
+    }
+  }
+  return result;
+}
@@ -5,6 +5,27 @@ const DEFAULT_OMIT_DESC_THRESHOLD_BYTES = 50000;
 
 type TextContent = { type: 'text'; text: string };
 
+function truncateText(text: string, maxLength: number): string {
+  const suffix = maxLength >= 3 ? '...' : '';
+  const sliceLength = Math.max(0, maxLength - suffix.length);
+  return `${text.slice(0, sliceLength)}${suffix}`;
+}
+
+/**
+ * Cap a card's description at maxLength, marking the card with descTruncated
+ * so callers know to fetch it with full=true for the rest.
+ */
+export function truncateDescription<T extends object>(
+  card: T,
+  maxLength: number | undefined
+): T | (T & { descTruncated: true }) {
+  const desc = (card as { desc?: unknown }).desc;
+  if (maxLength === undefined || typeof desc !== 'string' || desc.length <= maxLength) {
+    return card;
+  }
+  return { ...card, desc: truncateText(desc, maxLength), descTruncated: true };
+}
+
 export function formatCardListResponse(
   cards: TrelloCard[],
   options: {
@@ -23,9 +44,7 @@ export function formatCardListResponse(
     }
 
     anyTruncated = true;
-    const suffix = descMaxLength >= 3 ? '...' : '';
-    const sliceLength = Math.max(0, descMaxLength - suffix.length);
-    return { ...card, desc: `${card.desc.slice(0, sliceLength)}${suffix}` };
+    return { ...card, desc: truncateText(card.desc, descMaxLength) };
   });
 
   let resultCards: Array<TrelloCard | Omit<TrelloCard, 'desc'>> = previewCards;
@@ -0,0 +1,58 @@
+/**
+ * Run `fn` over `items` with at most `limit` calls in flight, preserving input
+ * order in the results. Each item settles independently, so one failure does
+ * not stop the rest.
+ */
+export async function mapWithConcurrency<T, R>(
+  items: readonly T[],
+  limit: number,
+  fn: (item: T, index: number) => Promise<R>
+): Promise<PromiseSettledResult<R>[]> {
+  const results: PromiseSettledResult<R>[] = new Array(items.length);
+  let next = 0;
+
+  const worker = async () => {
+    while (next < items.length) {
+      const index = next++;
+      try {
+        results[index] = { status: 'fulfilled', value: await fn(items[index], index) };
+      } catch (reason) {
+        results[index] = { status: 'rejected', reason };
+      }
+    }
+  };
+
+  const workers = Array.from({ length: Math.max(1, Math.min(limit, items.length)) }, worker);
+  await Promise.all(workers);
+  return results;
+}
+
+/**
+ * Split the results of mapWithConcurrency into successes and failures, each paired
+ * with the item and index it came from. Failures carry the rejection's message.
+ */
+export function partitionSettled<T, R>(
+  items: readonly T[],
+  settled: PromiseSettledResult<R>[]
+): {
+  fulfilled: Array<{ item: T; index: number; value: R }>;
+  rejected: Array<{ item: T; index: number; error: string }>;
+} {
+  const fulfilled: Array<{ item: T; index: number; value: R }> = [];
+  const rejected: Array<{ item: T; index: number; error: string }> = [];
+  settled.forEach((result, index) => {
+    if (result.status === 'fulfilled') {
+      fulfilled.push({ item: items[index], index, value: result.value });
+    } else {
+      rejected.push({ item: items[index], index, error: rejectionMessage(result.reason) });
+    }
+  });
+  return { fulfilled, rejected };
+}
+
+/**
+ * The message to report for a rejected item
+ */
+export function rejectionMessage(reason: unknown): string {
+  return reason instanceof Error ? reason.message : 'Unknown error';
+}
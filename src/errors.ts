@@ -0,0 +1,41 @@
+import axios from 'axios';
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+/**
+ * Trello did not answer within the configured timeout, even after retrying.
+ * Kept apart from HTTP errors so callers can tell a hung request from a 4xx/5xx.
+ */
+export class TrelloTimeoutError extends McpError {
+  constructor(
+    readonly timeoutMs: number,
+    readonly attempts: number
+  ) {
+    super(
+      ErrorCode.RequestTimeout,
+      `Trello API request timed out after ${timeoutMs}ms (${attempts} attempt${attempts === 1 ? '' : 's'})`
+    );
+    this.name = 'TrelloTimeoutError';
+  }
+}
+
+/**
+ * Whether an error is axios giving up on a request that exceeded its timeout
+ */
+export function isTimeoutError(error: unknown): boolean {
+  return (
+    axios.isAxiosError(error) && (error.code === 'ECONNABORTED' || error.code === 'ETIMEDOUT')
+  );
+}
+
+const IDEMPOTENT_METHODS = new Set(['get', 'head', 'options']);
+
+/**
+ * Whether a failed axios request can be sent again without side effects. A write
+ * that timed out may still have been applied by Trello, so it is never resent.
+ */
+export function isIdempotentRequest(error: unknown): boolean {
+  return (
+    axios.isAxiosError(error) &&
+    IDEMPOTENT_METHODS.has((error.config?.method ?? 'get').toLowerCase())
+  );
+}
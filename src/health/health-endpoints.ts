@@ -2,6 +2,9 @@ import { z } from 'zod/v4';
 import { TrelloHealthMonitor, SystemHealthReport, HealthStatus } from './health-monitor.js';
 import { TrelloClient } from '../trello-client.js';
 
+/** Latency above which a successful API ping is reported as degraded */
+const SLOW_API_THRESHOLD_MS = 2000;
+
 /**
  * Health endpoint result structure for MCP tools
  */
@@ -77,6 +80,47 @@ export class TrelloHealthEndpoints {
     }
   }
 
+  /**
+   * Trello API reachability - is it me or Trello?
+   * Pings the API once and reports latency, credential validity, and remaining quota.
+   */
+  async getApiHealth(): Promise<HealthEndpointResult> {
+    const ping = await this.trelloClient.pingApi();
+
+    let status: HealthStatus;
+    let message: string;
+    if (!ping.reachable) {
+      status = HealthStatus.CRITICAL;
+      message = `Trello API is unreachable: ${ping.error}`;
+    } else if (ping.credentialsValid === false) {
+      status = HealthStatus.CRITICAL;
+      message = 'Trello rejected the API key or token';
+    } else if (ping.httpStatus !== null && ping.httpStatus >= 400) {
+      status = HealthStatus.DEGRADED;
+      message = `Trello API responded with HTTP ${ping.httpStatus}`;
+    } else if (ping.latency_ms > SLOW_API_THRESHOLD_MS) {
+      status = HealthStatus.DEGRADED;
+      message = `Trello API is reachable but slow (${ping.latency_ms}ms)`;
+    } else {
+      status = HealthStatus.HEALTHY;
+      message = 'Trello API is reachable and credentials are valid';
+    }
+
+    return {
+      content: [
+        {
+          type: 'text',
+          text: JSON.stringify(
+            { status, message, timestamp: new Date().toISOString(), ...ping },
+            null,
+            2
+          ),
+        },
+      ],
+      isError: status === HealthStatus.CRITICAL,
+    };
+  }
+
   /**
    * GET /health/detailed
    * Comprehensive health diagnostic - the full medical examination!
@@ -526,6 +570,13 @@ export const HealthEndpointSchemas = {
     inputSchema: {},
   },
 
+  apiHealth: {
+    title: 'Trello API Health',
+    description:
+      'Ping the Trello API and report reachability, latency, whether the credentials are valid, the API base URL, and the remaining rate-limit quota',
+    inputSchema: {},
+  },
+
   detailedHealth: {
     title: 'Get Detailed Health',
     description: 'Get comprehensive system health diagnostic with all subsystem checks',
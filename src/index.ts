@@ -7,12 +7,85 @@ import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
 import { z } from 'zod/v4';
 import { TrelloClient } from './trello-client.js';
 import { TrelloHealthEndpoints, HealthEndpointSchemas } from './health/health-endpoints.js';
-import { formatCardListResponse } from './card-list-preview.js';
+import { formatCardListResponse, truncateDescription } from './card-list-preview.js';
+import { fetchPage } from './pagination.js';
+import { parseBoardExport } from './trello/export.js';
+import { formatCardLabels, LABEL_FORMATS } from './trello/labels.js';
+import { CHECK_ITEM_STATES, filterCheckListItems } from './trello/checklists.js';
+import { CARD_SORT_KEYS, sortCards } from './trello/positions.js';
+import { NAME_MATCHING_MODES, NameMatching } from './trello/name-matching.js';
+import { installValidationErrorFormatter } from './validation.js';
+import { installResponseShaping, OUTPUT_VERBOSITIES, OutputVerbosity } from './output-shaping.js';
+
+function readNumericEnv(name: string): number | undefined {
+  const raw = process.env[name];
+  if (raw === undefined || raw.trim() === '') {
+    return undefined;
+  }
+  const value = Number(raw);
+  if (!Number.isFinite(value) || value < 0) {
+    throw new Error(`${name} must be a non-negative number`);
+  }
+  return value;
+}
+
+function readPositiveIntegerEnv(name: string): number | undefined {
+  const value = readNumericEnv(name);
+  if (value !== undefined && (!Number.isInteger(value) || value === 0)) {
+    throw new Error(`${name} must be a positive integer`);
+  }
+  return value;
+}
+
+function readNameMatchingEnv(): NameMatching | undefined {
+  const raw = process.env.TRELLO_NAME_MATCHING?.trim();
+  if (!raw) {
+    return undefined;
+  }
+  if (!(NAME_MATCHING_MODES as readonly string[]).includes(raw)) {
+    throw new Error(`TRELLO_NAME_MATCHING must be one of: ${NAME_MATCHING_MODES.join(', ')}`);
+  }
+  return raw as NameMatching;
+}
+
+const bulkConcurrencySchema = z
+  .number()
+  .int()
+  .min(1)
+  .max(TrelloClient.MAX_BULK_CONCURRENCY)
+  .optional()
+  .default(TrelloClient.BULK_CONCURRENCY)
+  .describe(
+    `Requests kept in flight at once (default ${TrelloClient.BULK_CONCURRENCY}, max ${TrelloClient.MAX_BULK_CONCURRENCY}); lower it to stay clear of rate limits`
+  );
+
+const checkItemStateSchema = z
+  .enum(CHECK_ITEM_STATES)
+  .optional()
+  .default('all')
+  .describe(
+    'Only return "complete" or "incomplete" items, with overall stats for the checklist (default: "all", a plain item list)'
+  );
+
+const labelFormatSchema = z
+  .enum(LABEL_FORMATS)
+  .optional()
+  .describe(
+    'How to return card labels: "full" objects (default), bare "color" names, or compact "color:name" strings'
+  );
+
+const fullDescriptionSchema = z
+  .boolean()
+  .optional()
+  .default(false)
+  .describe('Return descriptions in full, ignoring the TRELLO_MAX_DESC_LENGTH cap (default: false)');
 
 class TrelloServer {
   private server: McpServer;
   private trelloClient: TrelloClient;
   private healthEndpoints: TrelloHealthEndpoints;
+  private maxDescLength?: number;
+  private outputVerbosity: OutputVerbosity = 'full';
 
   constructor() {
     const apiKey = process.env.TRELLO_API_KEY;
@@ -35,14 +108,24 @@ class TrelloServer {
       defaultBoardId,
       boardId: defaultBoardId,
       allowedWorkspaceIds,
+      maxRetries: readNumericEnv('TRELLO_MAX_RETRIES'),
+      baseDelayMs: readNumericEnv('TRELLO_RETRY_BASE_DELAY_MS'),
+      maxDelayMs: readNumericEnv('TRELLO_RETRY_MAX_DELAY_MS'),
+      timeoutMs: readNumericEnv('TRELLO_TIMEOUT_MS'),
+      maxAttachmentBytes: readPositiveIntegerEnv('TRELLO_MAX_ATTACHMENT_BYTES'),
+      exportDir: process.env.TRELLO_EXPORT_DIR,
+      nameMatching: readNameMatchingEnv(),
     });
 
+    this.maxDescLength = readNumericEnv('TRELLO_MAX_DESC_LENGTH');
     this.healthEndpoints = new TrelloHealthEndpoints(this.trelloClient);
 
     this.server = new McpServer({
       name: 'trello-server',
       version: '1.8.0',
     });
+    installValidationErrorFormatter(this.server);
+    installResponseShaping(this.server, () => this.outputVerbosity);
 
     this.setupTools();
     this.setupHealthEndpoints();
@@ -66,6 +149,28 @@ class TrelloServer {
     };
   }
 
+  /**
+   * Response for a destructive tool called with dryRun: describes the change
+   * that would have been made. Callers must return before any mutating request.
+   */
+  private dryRunResponse(wouldDo: string, target: unknown) {
+    return {
+      content: [
+        {
+          type: 'text' as const,
+          text: JSON.stringify({ dryRun: true, wouldDo, target }, null, 2),
+        },
+      ],
+    };
+  }
+
+  /**
+   * Apply the TRELLO_MAX_DESC_LENGTH cap to a card unless the caller asked for the full text
+   */
+  private capDescription<T extends object>(card: T, full?: boolean): T {
+    return full ? card : (truncateDescription(card, this.maxDescLength) as T);
+  }
+
   private setupTools() {
     // Get cards from a specific list
     this.server.registerTool(
@@ -83,13 +188,24 @@ class TrelloServer {
           fields: z
             .string()
             .optional()
-            .describe('Comma-separated list of fields to return (e.g., "name,idShort,labels,due,dueComplete"). Omit for all fields.'),
+            .describe('Comma-separated list of fields to return (e.g., "name,idShort,labels,due,dueComplete"). Omit to use the configured default for this tool, or all fields.'),
           nameFilter: z
             .string()
             .trim()
             .min(1, 'nameFilter must not be empty')
             .optional()
             .describe('Optional substring to filter cards by name (case-insensitive)'),
+          filterLabels: z
+            .array(z.string().min(1))
+            .optional()
+            .describe(
+              'Only return cards with these labels. Entries are label IDs or color names (e.g. "red"); a color matches every label of that color on the board.'
+            ),
+          filterLabelsMode: z
+            .enum(['any', 'all'])
+            .optional()
+            .default('any')
+            .describe('Whether a card needs any (default) or all of filterLabels'),
           descMaxLength: z
             .number()
             .int()
@@ -106,12 +222,191 @@ class TrelloServer {
             .describe(
               'Approximate response size threshold before descriptions are omitted. Defaults to 50000 bytes.'
             ),
+          labelFormat: labelFormatSchema,
+          full: fullDescriptionSchema,
+          sortBy: z
+            .enum(CARD_SORT_KEYS)
+            .optional()
+            .default('pos')
+            .describe('Order cards by list position (default), due date, name or last activity'),
+          order: z
+            .enum(['asc', 'desc'])
+            .optional()
+            .default('asc')
+            .describe('Sort direction (default: asc). Cards without a due date always come last.'),
+        },
+      },
+      async ({
+        boardId,
+        listId,
+        fields,
+        nameFilter,
+        filterLabels,
+        filterLabelsMode,
+        descMaxLength,
+        omitDescThresholdBytes,
+        labelFormat,
+        full,
+        sortBy,
+        order,
+      }) => {
+        try {
+          let requestedFields =
+            fields ?? this.trelloClient.getDefaultFields('get_cards_by_list_id');
+          const sorted = sortBy !== 'pos' || order !== 'asc';
+          if (sorted && requestedFields && !requestedFields.split(',').includes(sortBy)) {
+            requestedFields = `${requestedFields},${sortBy}`;
+          }
+          const fetched = await this.trelloClient.getCardsByList(
+            listId,
+            requestedFields,
+            nameFilter,
+            filterLabels && { labels: filterLabels, mode: filterLabelsMode, boardId }
+          );
+          const cards = sorted ? sortCards(fetched, sortBy, order) : fetched;
+          const formatted = cards.map(card =>
+            this.capDescription(labelFormat ? formatCardLabels(card, labelFormat) : card, full)
+          );
+          return formatCardListResponse(formatted, {
+            descMaxLength: descMaxLength ?? (full ? Number.POSITIVE_INFINITY : undefined),
+            omitDescThresholdBytes,
+          });
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Search the cards of one board
+    this.server.registerTool(
+      'search_cards_in_board',
+      {
+        title: 'Search Cards in Board',
+        description:
+          "Search one board's cards with Trello search (matches names, descriptions, comments and checklists). Returns each match with the list it is in.",
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          query: z.string().trim().min(1).describe('Search text; supports Trello search operators'),
+          limit: z
+            .number()
+            .int()
+            .min(1)
+            .max(1000)
+            .optional()
+            .default(10)
+            .describe('Maximum number of cards to return (default: 10)'),
+          partial: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Match the last word of the query as a prefix, e.g. "deplo" finds "deploy" (default: false)'),
+        },
+      },
+      async ({ boardId, query, limit, partial }) => {
+        try {
+          const cards = await this.trelloClient.searchCardsInBoard({
+            boardId,
+            query,
+            limit,
+            partial,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(cards, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get a board's open cards with filters
+    this.server.registerTool(
+      'get_board_cards',
+      {
+        title: 'Get Board Cards',
+        description:
+          'Fetch the open cards across a whole board in one request, optionally narrowed by members, due date, overdue status and label color. Filters combine; omit a filter to ignore it.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          fields: z
+            .string()
+            .optional()
+            .describe('Comma-separated list of card fields to return (e.g., "name,idList,due"). Omit to use the configured default for this tool, or all fields.'),
+          hasMembers: z
+            .boolean()
+            .optional()
+            .describe('true for cards with at least one member, false for unassigned cards'),
+          hasDue: z
+            .boolean()
+            .optional()
+            .describe('true for cards with a due date, false for cards without one'),
+          overdue: z
+            .boolean()
+            .optional()
+            .describe('true for incomplete cards past their due date, false to exclude them'),
+          labelColor: z
+            .string()
+            .min(1)
+            .optional()
+            .describe('Only cards with a label of this color (e.g. "red")'),
+          full: fullDescriptionSchema,
+        },
+      },
+      async ({ boardId, fields, hasMembers, hasDue, overdue, labelColor, full }) => {
+        try {
+          const cards = await this.trelloClient.findBoardCards({
+            boardId,
+            fields: fields ?? this.trelloClient.getDefaultFields('get_board_cards'),
+            hasMembers,
+            hasDue,
+            overdue,
+            labelColor,
+          });
+          return formatCardListResponse(
+            cards.map(card => this.capDescription(card, full)),
+            { descMaxLength: full ? Number.POSITIVE_INFINITY : undefined }
+          );
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Count cards in a list
+    this.server.registerTool(
+      'get_list_cards_count',
+      {
+        title: 'Get List Cards Count',
+        description:
+          'Return only the number of cards in a list, e.g. for WIP checks like "how many cards are in Doing?". Much cheaper than fetching the cards.',
+        inputSchema: {
+          listId: z.string().describe('ID of the list'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board the list is on; when given, the list is checked against it'),
+          includeClosed: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Also count archived cards (default: false)'),
         },
       },
-      async ({ listId, fields, nameFilter, descMaxLength, omitDescThresholdBytes }) => {
+      async ({ listId, boardId, includeClosed }) => {
         try {
-          const cards = await this.trelloClient.getCardsByList(listId, fields, nameFilter);
-          return formatCardListResponse(cards, { descMaxLength, omitDescThresholdBytes });
+          const count = await this.trelloClient.getListCardsCount(listId, {
+            boardId,
+            includeClosed,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify({ listId, count }) }],
+          };
         } catch (error) {
           return this.handleError(error);
         }
@@ -129,11 +424,16 @@ class TrelloServer {
             .string()
             .optional()
             .describe('ID of the Trello board (uses default if not provided)'),
+          noCache: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Skip the ETag cache and always fetch a fresh copy (default: false)'),
         },
       },
-      async ({ boardId }) => {
+      async ({ boardId, noCache }) => {
         try {
-          const lists = await this.trelloClient.getLists(boardId);
+          const lists = await this.trelloClient.getLists(boardId, { noCache });
           return {
             content: [{ type: 'text' as const, text: JSON.stringify(lists, null, 2) }],
           };
@@ -148,7 +448,8 @@ class TrelloServer {
       'get_recent_activity',
       {
         title: 'Get Recent Activity',
-        description: 'Fetch recent activity on the Trello board',
+        description:
+          'Fetch recent activity on the Trello board, newest first. Returns { items, nextCursor }; pass nextCursor back as cursor to fetch older activity.',
         inputSchema: {
           boardId: z
             .string()
@@ -167,13 +468,22 @@ class TrelloServer {
             .string()
             .optional()
             .describe('Only return actions before this date (ISO 8601) or action ID'),
+          cursor: z
+            .string()
+            .optional()
+            .describe('nextCursor from a previous response, to fetch the following page'),
         },
       },
-      async ({ boardId, limit, since, before }) => {
+      async ({ boardId, limit, since, before, cursor }) => {
         try {
-          const activity = await this.trelloClient.getRecentActivity(boardId, limit, since, before);
+          if (cursor && before) {
+            throw new McpError(ErrorCode.InvalidParams, 'Pass either cursor or before, not both');
+          }
+          const page = await fetchPage(limit, cursor, pageBefore =>
+            this.trelloClient.getRecentActivity(boardId, limit, since, pageBefore ?? before)
+          );
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(activity, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(page, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -186,13 +496,19 @@ class TrelloServer {
       'add_card_to_list',
       {
         title: 'Add Card to List',
-        description: 'Add a new card to a specified list on a specific board',
+        description:
+          "Add a new card to a specified list on a specific board. Without listId the card goes to the board's default list (see set_default_list).",
         inputSchema: {
           boardId: z
             .string()
             .optional()
             .describe('ID of the Trello board (uses default if not provided)'),
-          listId: z.string().describe('ID of the list to add the card to'),
+          listId: z
+            .string()
+            .optional()
+            .describe(
+              "ID of the list to add the card to (uses the board's default list if not provided)"
+            ),
           name: z.string().describe('Name of the card'),
           description: z.string().optional().describe('Description of the card'),
           dueDate: z.string().optional().describe('Due date for the card (ISO 8601 format)'),
@@ -207,16 +523,27 @@ class TrelloServer {
           start: z
             .string()
             .optional()
-            .describe('Start date for the card (YYYY-MM-DD format, date only)'),
+            .describe(
+              'Start date for the card (YYYY-MM-DD format, date only); must not be after dueDate'
+            ),
           labels: z
             .array(z.string())
             .optional()
             .describe('Array of label IDs to apply to the card'),
+          idempotencyKey: z
+            .string()
+            .min(1)
+            .optional()
+            .describe(
+              'Optional client-chosen key. Retrying with the same key within 10 minutes returns the originally created card instead of creating a duplicate.'
+            ),
         },
       },
       async args => {
         try {
-          const card = await this.trelloClient.addCard(args.boardId, args);
+          const listId =
+            args.listId ?? (await this.trelloClient.resolveDefaultListId(args.boardId));
+          const card = await this.trelloClient.addCard(args.boardId, { ...args, listId });
           return {
             content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
@@ -278,25 +605,34 @@ class TrelloServer {
       }
     );
 
-    // Archive a card
+    // Due date reminder
     this.server.registerTool(
-      'archive_card',
+      'set_card_due_reminder',
       {
-        title: 'Archive Card',
-        description: 'Send a card to the archive on a specific board',
+        title: 'Set Card Due Reminder',
+        description:
+          "Set when a card's members are reminded of its due date, in minutes before it: -1 (none), 0 (at the due time), 5, 10, 15, 60, 120, 1440 (one day) or 2880 (two days). Returns the updated reminder.",
         inputSchema: {
           boardId: z
             .string()
             .optional()
             .describe('ID of the Trello board (uses default if not provided)'),
-          cardId: z.string().describe('ID of the card to archive'),
+          cardId: z.string().describe('ID of the card'),
+          reminderMinutes: z
+            .number()
+            .int()
+            .describe('Minutes before the due date to send the reminder, or -1 for none'),
         },
       },
-      async ({ boardId, cardId }) => {
+      async ({ boardId, cardId, reminderMinutes }) => {
         try {
-          const card = await this.trelloClient.archiveCard(boardId, cardId);
+          const result = await this.trelloClient.setCardDueReminder(
+            boardId,
+            cardId,
+            reminderMinutes
+          );
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -304,20 +640,52 @@ class TrelloServer {
       }
     );
 
-    // ─── Watch Card (subscribe/unsubscribe) ──
+    // Generic partial card update
     this.server.registerTool(
-      'watch_card',
+      'update_card',
       {
-        title: 'Watch Card',
-        description: 'Subscribe or unsubscribe from watching a card for activity notifications',
+        title: 'Update Card',
+        description:
+          "Update any subset of a card's fields in one call using Trello field names; only the fields you pass are changed. dueComplete requires the card to have (or be given) a due date. Moving the card with idList into a list at its configured WIP limit is refused unless override is set.",
         inputSchema: {
-          cardId: z.string().describe('ID of the card to watch/unwatch'),
-          subscribed: z.boolean().describe('Set to true to start watching, false to stop'),
+          cardId: z.string().describe('ID of the card to update'),
+          name: z.string().optional().describe('New name'),
+          desc: z.string().optional().describe('New description'),
+          due: z
+            .string()
+            .nullable()
+            .optional()
+            .describe('Due date (ISO 8601), or null to clear it'),
+          start: z
+            .string()
+            .nullable()
+            .optional()
+            .describe('Start date (ISO 8601), or null to clear it'),
+          dueComplete: z.boolean().optional().describe('Whether the due date is complete'),
+          idList: z.string().optional().describe('ID of the list to move the card to'),
+          pos: z
+            .union([z.string(), z.number()])
+            .optional()
+            .describe('Position in the list: "top", "bottom", or a positive number'),
+          closed: z.boolean().optional().describe('true to archive the card, false to restore it'),
+          idLabels: z
+            .array(z.string())
+            .optional()
+            .describe('Complete list of label IDs the card should have'),
+          idMembers: z
+            .array(z.string())
+            .optional()
+            .describe('Complete list of member IDs the card should have'),
+          override: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Move even if the idList list is at its configured WIP limit (default: false)'),
         },
       },
-      async ({ cardId, subscribed }) => {
+      async ({ cardId, override, ...fields }) => {
         try {
-          const card = await this.trelloClient.watchCard(cardId, subscribed);
+          const card = await this.trelloClient.patchCard(cardId, fields, { override });
           return {
             content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
@@ -327,22 +695,28 @@ class TrelloServer {
       }
     );
 
-    // ─── Watch List (subscribe/unsubscribe) ──
+    // Single-field card edits
     this.server.registerTool(
-      'watch_list',
+      'rename_card',
       {
-        title: 'Watch List',
-        description: 'Subscribe or unsubscribe from watching a list for activity notifications',
+        title: 'Rename Card',
+        description:
+          "Change only a card's name. Prefer this over broader update tools when the name is the only thing to change.",
         inputSchema: {
-          listId: z.string().describe('ID of the list to watch/unwatch'),
-          subscribed: z.boolean().describe('Set to true to start watching, false to stop'),
+          cardId: z.string().describe('ID of the card to rename'),
+          name: z.string().trim().min(1).describe('New name for the card'),
         },
       },
-      async ({ listId, subscribed }) => {
+      async ({ cardId, name }) => {
         try {
-          const list = await this.trelloClient.watchList(listId, subscribed);
+          const card = await this.trelloClient.patchCard(cardId, { name });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify({ cardId: card.id, name: card.name }, null, 2),
+              },
+            ],
           };
         } catch (error) {
           return this.handleError(error);
@@ -350,34 +724,27 @@ class TrelloServer {
       }
     );
 
-    // Move a card
     this.server.registerTool(
-      'move_card',
+      'set_card_description',
       {
-        title: 'Move Card',
-        description: 'Move a card to a different list, potentially on a different board',
+        title: 'Set Card Description',
+        description:
+          "Replace only a card's description (markdown). Pass an empty string to clear it. Prefer this over broader update tools when the description is the only thing to change.",
         inputSchema: {
-          boardId: z
-            .string()
-            .optional()
-            .describe(
-              'ID of the target Trello board (where the listId resides, uses default if not provided)'
-            ),
-          cardId: z.string().describe('ID of the card to move'),
-          listId: z.string().describe('ID of the target list'),
-          pos: z
-            .union([z.string(), z.number()])
-            .optional()
-            .describe(
-              'Position of the card in the target list. Accepts "top", "bottom", or a positive number'
-            ),
+          cardId: z.string().describe('ID of the card'),
+          desc: z.string().describe('New description for the card'),
         },
       },
-      async ({ boardId, cardId, listId, pos }) => {
+      async ({ cardId, desc }) => {
         try {
-          const card = await this.trelloClient.moveCard(boardId, cardId, listId, pos);
+          const card = await this.trelloClient.patchCard(cardId, { desc });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify({ cardId: card.id, desc: card.desc }, null, 2),
+              },
+            ],
           };
         } catch (error) {
           return this.handleError(error);
@@ -385,25 +752,22 @@ class TrelloServer {
       }
     );
 
-    // Add a new list to a board
+    // Reorder within the current list
     this.server.registerTool(
-      'add_list_to_board',
+      'move_card_to_top_of_list',
       {
-        title: 'Add List to Board',
-        description: 'Add a new list to the specified board',
+        title: 'Move Card to Top of List',
+        description:
+          'Bump a card to the top of the list it is already in, e.g. "put this at the top of the backlog". Returns the new position; undo_last_move reverts it.',
         inputSchema: {
-          boardId: z
-            .string()
-            .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
-          name: z.string().describe('Name of the new list'),
+          cardId: z.string().describe('ID of the card to move'),
         },
       },
-      async ({ boardId, name }) => {
+      async ({ cardId }) => {
         try {
-          const list = await this.trelloClient.addList(boardId, name);
+          const result = await this.trelloClient.moveCardToListEdge(cardId, 'top');
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -411,25 +775,21 @@ class TrelloServer {
       }
     );
 
-    // Archive a list
     this.server.registerTool(
-      'archive_list',
+      'move_card_to_bottom_of_list',
       {
-        title: 'Archive List',
-        description: 'Send a list to the archive on a specific board',
+        title: 'Move Card to Bottom of List',
+        description:
+          'Send a card to the bottom of the list it is already in. Returns the new position; undo_last_move reverts it.',
         inputSchema: {
-          boardId: z
-            .string()
-            .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
-          listId: z.string().describe('ID of the list to archive'),
+          cardId: z.string().describe('ID of the card to move'),
         },
       },
-      async ({ boardId, listId }) => {
+      async ({ cardId }) => {
         try {
-          const list = await this.trelloClient.archiveList(boardId, listId);
+          const result = await this.trelloClient.moveCardToListEdge(cardId, 'bottom');
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -437,45 +797,39 @@ class TrelloServer {
       }
     );
 
-    // Update a list
+    // Archive a card
     this.server.registerTool(
-      'update_list',
+      'archive_card',
       {
-        title: 'Update List',
-        description:
-          'Update a list name, archive state, subscription state, or board. Use update_list_position for moving a list within a board.',
+        title: 'Archive Card',
+        description: 'Send a card to the archive on a specific board',
         inputSchema: {
-          listId: z.string().describe('ID of the Trello list to update'),
-          name: z.string().optional().describe('New name for the list'),
-          closed: z.boolean().optional().describe('Whether to close (archive) the list'),
-          subscribed: z
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          cardId: z.string().describe('ID of the card to archive'),
+          dryRun: z
             .boolean()
             .optional()
-            .describe('Whether the authenticated user is subscribed to the list'),
-          idBoard: z.string().optional().describe('ID of a board to move the list to'),
+            .default(false)
+            .describe('Preview what would happen without making any changes (default: false)'),
         },
       },
-      async ({ listId, name, closed, subscribed, idBoard }) => {
+      async ({ boardId, cardId, dryRun }) => {
         try {
-          const params: {
-            name?: string;
-            closed?: boolean;
-            subscribed?: boolean;
-            idBoard?: string;
-          } = {};
-          if (name !== undefined) params.name = name;
-          if (closed !== undefined) params.closed = closed;
-          if (subscribed !== undefined) params.subscribed = subscribed;
-          if (idBoard !== undefined) params.idBoard = idBoard;
-          if (Object.keys(params).length === 0) {
-            throw new McpError(
-              ErrorCode.InvalidParams,
-              'At least one of name, closed, subscribed, or idBoard must be provided'
+          if (dryRun) {
+            const target = await this.trelloClient.getCardById(cardId, 'name,idList,closed');
+            return this.dryRunResponse(
+              target.closed
+                ? `Card "${target.name}" is already archived; nothing would change`
+                : `Would archive card "${target.name}"`,
+              target
             );
           }
-          const list = await this.trelloClient.updateList(listId, params);
+          const card = await this.trelloClient.archiveCard(boardId, cardId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -483,39 +837,30 @@ class TrelloServer {
       }
     );
 
-    // Update list position
+    // Browse archived cards
     this.server.registerTool(
-      'update_list_position',
+      'get_archived_cards',
       {
-        title: 'Update List Position',
+        title: 'Get Archived Cards',
         description:
-          'Update the position of a list on the board. Trello uses fractional indexing: each list has a float position, and to place a list between two others, use the average of their positions (e.g., between pos 1024 and 2048, use 1536). Use "top"/"bottom" shortcuts to move to the edges.',
+          'List the archived cards in a list, most recently active first, e.g. to find cards archived by mistake. Restore one with unarchive_card.',
         inputSchema: {
-          listId: z.string().describe('ID of the list to reposition'),
-          position: z
-            .string()
-            .refine(
-              (val) => {
-                if (val === 'top' || val === 'bottom') return true;
-                const num = Number(val);
-                return num > 0 && isFinite(num);
-              },
-              {
-                message: "Position must be 'top', 'bottom', or a positive finite numeric string.",
-              }
-            )
-            .describe(
-              'New position: "top" (move to leftmost), "bottom" (move to rightmost), or a numeric string (e.g. "1536"). To place between two lists, use the average of their pos values.'
-            ),
+          listId: z.string().describe('ID of the list'),
+          limit: z
+            .number()
+            .int()
+            .min(1)
+            .max(1000)
+            .optional()
+            .default(50)
+            .describe('Maximum number of cards to return (default: 50)'),
         },
       },
-      async ({ listId, position }) => {
+      async ({ listId, limit }) => {
         try {
-          const parsedPosition =
-            position === 'top' || position === 'bottom' ? position : Number(position);
-          const list = await this.trelloClient.updateListPosition(listId, parsedPosition);
+          const cards = await this.trelloClient.getArchivedCards(listId, limit);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(cards, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -523,19 +868,21 @@ class TrelloServer {
       }
     );
 
-    // Get cards assigned to current user
+    // Restore an archived card
     this.server.registerTool(
-      'get_my_cards',
+      'unarchive_card',
       {
-        title: 'Get My Cards',
-        description: 'Fetch all cards assigned to the current user',
-        inputSchema: {},
+        title: 'Unarchive Card',
+        description: 'Restore an archived card to the list it was archived from',
+        inputSchema: {
+          cardId: z.string().describe('ID of the archived card'),
+        },
       },
-      async () => {
+      async ({ cardId }) => {
         try {
-          const cards = await this.trelloClient.getMyCards();
+          const card = await this.trelloClient.unarchiveCard(cardId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(cards, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -543,38 +890,55 @@ class TrelloServer {
       }
     );
 
-    // Attach image to card (kept for backward compatibility)
+    // Archive stale cards
     this.server.registerTool(
-      'attach_image_to_card',
+      'archive_cards_older_than',
       {
-        title: 'Attach Image to Card',
-        description: 'Attach an image to a card from a URL on a specific board',
+        title: 'Archive Cards Older Than',
+        description:
+          'Backlog cleanup: archive open cards on a list (or a whole board) with no activity in the last olderThanDays days. Defaults to a dry run that only lists the cards; pass dryRun: false to archive them.',
         inputSchema: {
-          boardId: z
+          listId: z
             .string()
             .optional()
-            .describe(
-              'ID of the Trello board where the card exists (uses default if not provided)'
-            ),
-          cardId: z.string().describe('ID of the card to attach the image to'),
-          imageUrl: z.string().describe('URL of the image to attach'),
-          name: z
+            .describe('ID of the list to clean up (takes precedence over boardId)'),
+          boardId: z
             .string()
             .optional()
-            .default('Image Attachment')
-            .describe('Optional name for the attachment (defaults to "Image Attachment")'),
+            .describe('ID of the board to clean up when no listId is given (uses default if not provided)'),
+          olderThanDays: z
+            .number()
+            .positive()
+            .describe('Archive cards whose last activity is more than this many days ago'),
+          dryRun: z
+            .boolean()
+            .optional()
+            .default(true)
+            .describe('Only list the cards that would be archived (default: true)'),
+          concurrency: bulkConcurrencySchema,
         },
       },
-      async ({ boardId, cardId, imageUrl, name }) => {
+      async ({ listId, boardId, olderThanDays, dryRun, concurrency }) => {
         try {
-          const attachment = await this.trelloClient.attachImageToCard(
+          const { cutoff, cards } = await this.trelloClient.findInactiveCards({
+            listId,
             boardId,
-            cardId,
-            imageUrl,
-            name
+            olderThanDays,
+          });
+          if (dryRun) {
+            return this.dryRunResponse(
+              `Would archive ${cards.length} card(s) with no activity since ${cutoff}`,
+              cards
+            );
+          }
+          const result = await this.trelloClient.archiveCards(
+            cards.map(card => card.id),
+            concurrency
           );
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+            content: [
+              { type: 'text' as const, text: JSON.stringify({ cutoff, ...result }, null, 2) },
+            ],
           };
         } catch (error) {
           return this.handleError(error);
@@ -582,105 +946,46 @@ class TrelloServer {
       }
     );
 
-    // Attach file to card (generic file attachment)
+    // ─── Watch Card (subscribe/unsubscribe) ──
     this.server.registerTool(
-      'attach_file_to_card',
+      'watch_card',
       {
-        title: 'Attach File to Card',
-        description: 'Attach any file to a card from a URL on a specific board',
+        title: 'Watch Card',
+        description:
+          'Subscribe or unsubscribe from watching a card for activity notifications. Returns the whole card; use set_card_subscription to get just the new subscription state.',
         inputSchema: {
-          boardId: z
-            .string()
-            .optional()
-            .describe(
-              'ID of the Trello board where the card exists (uses default if not provided)'
-            ),
-          cardId: z.string().describe('ID of the card to attach the file to'),
-          fileUrl: z.string().describe('URL of the file to attach'),
-          name: z
-            .string()
-            .optional()
-            .default('File Attachment')
-            .describe('Optional name for the attachment (defaults to "File Attachment")'),
-          mimeType: z
-            .string()
-            .optional()
-            .describe(
-              'Optional MIME type of the file (e.g., "application/pdf", "text/plain", "video/mp4")'
-            ),
+          cardId: z.string().describe('ID of the card to watch/unwatch'),
+          subscribed: z.boolean().describe('Set to true to start watching, false to stop'),
         },
       },
-      async ({ boardId, cardId, fileUrl, name, mimeType }) => {
+      async ({ cardId, subscribed }) => {
         try {
-          const attachment = await this.trelloClient.attachFileToCard(
-            boardId,
-            cardId,
-            fileUrl,
-            name,
-            mimeType
-          );
+          const card = await this.trelloClient.watchCard(cardId, subscribed);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
         } catch (error) {
-          return {
-            content: [
-              {
-                type: 'text' as const,
-                text: `Error: ${error instanceof Error ? error.message : 'Unknown error occurred'}`,
-              },
-            ],
-            isError: true,
-          };
+          return this.handleError(error);
         }
       }
     );
 
-    // Attach arbitrary binary data to a card (base64 or data URL)
     this.server.registerTool(
-      'attach_data_to_card',
+      'set_card_subscription',
       {
-        title: 'Attach Data to Card',
+        title: 'Set Card Subscription',
         description:
-          'Attach binary data (image, markdown, PDF, text, etc.) to a card from base64-encoded data or a data URL. Use this for any non-image content. For image/screenshot uploads with PNG defaults, see attach_image_data_to_card.',
+          'Subscribe or unsubscribe yourself to notifications for a card, e.g. after creating a card you do or do not want to follow. Returns the new subscription state.',
         inputSchema: {
-          boardId: z
-            .string()
-            .optional()
-            .describe(
-              'ID of the Trello board where the card exists (uses default if not provided)'
-            ),
-          cardId: z.string().describe('ID of the card to attach the data to'),
-          data: z
-            .string()
-            .describe(
-              'Base64-encoded data or a data URL (e.g. data:text/markdown;base64,...). Any content type, not just images.'
-            ),
-          name: z
-            .string()
-            .optional()
-            .describe(
-              'Filename for the attachment, including extension (e.g. "notes.md", "report.pdf"). Defaults to "attachment-<timestamp>".'
-            ),
-          mimeType: z
-            .string()
-            .optional()
-            .describe(
-              'MIME type of the data (e.g. "text/markdown", "application/pdf", "image/png"). Recommended for correct rendering in Trello. If omitted, inferred from a data URL prefix or the filename extension; falls back to "application/octet-stream".'
-            ),
+          cardId: z.string().describe('ID of the card'),
+          subscribed: z.boolean().describe('true to subscribe, false to unsubscribe'),
         },
       },
-      async ({ boardId, cardId, data, name, mimeType }) => {
+      async ({ cardId, subscribed }) => {
         try {
-          const attachment = await this.trelloClient.attachDataToCard(
-            boardId,
-            cardId,
-            data,
-            name,
-            mimeType
-          );
+          const state = await this.trelloClient.setCardSubscription(cardId, subscribed);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(state, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -688,44 +993,22 @@ class TrelloServer {
       }
     );
 
-    // Attach image data to card (image-flavored convenience over attach_data_to_card)
+    // ─── Watch List (subscribe/unsubscribe) ──
     this.server.registerTool(
-      'attach_image_data_to_card',
+      'watch_list',
       {
-        title: 'Attach Image Data to Card',
-        description:
-          'Attach an image to a card from base64 data or a data URL. Image-flavored convenience over attach_data_to_card: defaults assume PNG when mimeType/name are omitted, suitable for screenshot pasting. For non-image content, use attach_data_to_card.',
+        title: 'Watch List',
+        description: 'Subscribe or unsubscribe from watching a list for activity notifications',
         inputSchema: {
-          boardId: z
-            .string()
-            .optional()
-            .describe(
-              'ID of the Trello board where the card exists (uses default if not provided)'
-            ),
-          cardId: z.string().describe('ID of the card to attach the image to'),
-          imageData: z.string().describe('Base64 encoded image data or data URL (e.g., data:image/png;base64,...)'),
-          name: z
-            .string()
-            .optional()
-            .describe('Optional name for the attachment'),
-          mimeType: z
-            .string()
-            .optional()
-            .default('image/png')
-            .describe('Optional MIME type (default: image/png)'),
+          listId: z.string().describe('ID of the list to watch/unwatch'),
+          subscribed: z.boolean().describe('Set to true to start watching, false to stop'),
         },
       },
-      async ({ boardId, cardId, imageData, name, mimeType }) => {
+      async ({ listId, subscribed }) => {
         try {
-          const attachment = await this.trelloClient.attachImageDataToCard(
-            boardId,
-            cardId,
-            imageData,
-            name,
-            mimeType
-          );
+          const list = await this.trelloClient.watchList(listId, subscribed);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -733,19 +1016,22 @@ class TrelloServer {
       }
     );
 
-    // List all boards
+    // List change detection without webhooks
     this.server.registerTool(
-      'list_boards',
+      'snapshot_list',
       {
-        title: 'List Boards',
-        description: 'List all boards the user has access to',
-        inputSchema: {},
+        title: 'Snapshot List',
+        description:
+          "Remember a list's cards (IDs, names, last activity, positions) in server memory so diff_list_since can later report what changed. Snapshots are lost when the server restarts.",
+        inputSchema: {
+          listId: z.string().describe('ID of the list to snapshot'),
+        },
       },
-      async () => {
+      async ({ listId }) => {
         try {
-          const boards = await this.trelloClient.listBoards();
+          const result = await this.trelloClient.snapshotList(listId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(boards, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -753,24 +1039,300 @@ class TrelloServer {
       }
     );
 
-    // Set active board
     this.server.registerTool(
-      'set_active_board',
+      'diff_list_since',
       {
-        title: 'Set Active Board',
-        description: 'Set the active board for future operations',
+        title: 'Diff List Since Snapshot',
+        description:
+          'Report cards added to, removed from (moved, archived, or deleted), updated in, or reordered within a list since its last snapshot_list, e.g. "what changed in Review since I last looked?"',
         inputSchema: {
-          boardId: z.string().describe('ID of the board to set as active'),
+          listId: z.string().describe('ID of a list previously passed to snapshot_list'),
+          resetSnapshot: z
+            .boolean()
+            .optional()
+            .default(true)
+            .describe('Replace the snapshot with the current state after diffing (default: true)'),
         },
       },
-      async ({ boardId }) => {
+      async ({ listId, resetSnapshot }) => {
         try {
-          const board = await this.trelloClient.setActiveBoard(boardId);
+          const diff = await this.trelloClient.diffListSinceSnapshot(listId, resetSnapshot);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(diff, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Move a card
+    this.server.registerTool(
+      'move_card',
+      {
+        title: 'Move Card',
+        description:
+          'Move a card to a different list, potentially on a different board. Use pos for "top"/"bottom"/numeric placement, or relativeTo + placement to put the card directly above or below another card in the target list. Moves into a list at its configured WIP limit are refused unless override is set.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the target Trello board (where the listId resides, uses default if not provided)'
+            ),
+          cardId: z.string().describe('ID of the card to move'),
+          listId: z.string().describe('ID of the target list'),
+          pos: z
+            .union([z.string(), z.number()])
+            .optional()
+            .describe(
+              'Position of the card in the target list. Accepts "top", "bottom", or a positive number'
+            ),
+          relativeTo: z
+            .string()
+            .optional()
+            .describe('ID of a card in the target list to place this card next to (overrides pos)'),
+          placement: z
+            .enum(['above', 'below'])
+            .optional()
+            .describe('Whether to place the card directly above or below relativeTo (default: above)'),
+          override: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Move even if the target list is at its configured WIP limit (default: false)'),
+        },
+      },
+      async ({ boardId, cardId, listId, pos, relativeTo, placement, override }) => {
+        try {
+          const position = relativeTo
+            ? await this.trelloClient.computeRelativeCardPosition(
+                cardId,
+                listId,
+                relativeTo,
+                placement ?? 'above'
+              )
+            : pos;
+          const card = await this.trelloClient.moveCard(boardId, cardId, listId, position, {
+            override,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Undo the last card move
+    this.server.registerTool(
+      'undo_last_move',
+      {
+        title: 'Undo Last Move',
+        description:
+          'Move the most recently moved card back to its previous list and position. Only the last move_card is remembered, and any other change made since then clears it.',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const card = await this.trelloClient.undoLastMove();
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Sort the cards in a list
+    this.server.registerTool(
+      'sort_list',
+      {
+        title: 'Sort List',
+        description:
+          'Reorder all cards in a list by due date, name, or last activity. Cards without a due date go last. Returns the new order and any cards that could not be moved.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board the list is on; when given, the list is checked against it'),
+          listId: z.string().describe('ID of the list to sort'),
+          sortBy: z.enum(['due', 'name', 'dateLastActivity']).describe('Card field to sort by'),
+          order: z
+            .enum(['asc', 'desc'])
+            .optional()
+            .default('asc')
+            .describe('Sort direction (default: asc)'),
+          concurrency: bulkConcurrencySchema,
+        },
+      },
+      async ({ boardId, listId, sortBy, order, concurrency }) => {
+        try {
+          const result = await this.trelloClient.sortList({
+            listId,
+            sortBy,
+            order,
+            boardId,
+            concurrency,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Compact card positions
+    this.server.registerTool(
+      'normalize_positions',
+      {
+        title: 'Normalize Positions',
+        description:
+          'Maintenance: give every card in a list clean, evenly spaced positions while keeping the current order, so later relative moves stay predictable. Returns each changed card with its before/after position.',
+        inputSchema: {
+          listId: z.string().describe('ID of the list to normalize'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board the list is on; when given, the list is checked against it'),
+          dryRun: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Preview what would happen without making any changes (default: false)'),
+          concurrency: bulkConcurrencySchema,
+        },
+      },
+      async ({ listId, boardId, dryRun, concurrency }) => {
+        try {
+          const result = await this.trelloClient.normalizeListPositions({
+            listId,
+            boardId,
+            dryRun,
+            concurrency,
+          });
+          if (dryRun) {
+            return this.dryRunResponse(
+              `Would reposition ${result.changes.length} card(s) in list ${listId}`,
+              result.changes
+            );
+          }
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Add a new list to a board
+    this.server.registerTool(
+      'add_list_to_board',
+      {
+        title: 'Add List to Board',
+        description: 'Add a new list to the specified board',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          name: z.string().describe('Name of the new list'),
+        },
+      },
+      async ({ boardId, name }) => {
+        try {
+          const list = await this.trelloClient.addList(boardId, name);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'create_list',
+      {
+        title: 'Create List',
+        description:
+          'Create a list on a board at a chosen position, optionally copying the cards of an existing list (idListSource). The board is checked before anything is created.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          name: z.string().min(1).describe('Name of the new list'),
+          pos: z
+            .union([z.enum(['top', 'bottom']), z.number().positive()])
+            .optional()
+            .describe('Position on the board: "top", "bottom", or a positive number'),
+          idListSource: z
+            .string()
+            .optional()
+            .describe('ID of a list whose cards are copied into the new list'),
+        },
+      },
+      async ({ boardId, name, pos, idListSource }) => {
+        try {
+          const list = await this.trelloClient.createList({ boardId, name, pos, idListSource });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Duplicate a list with its cards
+    this.server.registerTool(
+      'duplicate_list',
+      {
+        title: 'Duplicate List',
+        description:
+          'Create a copy of a list including all of its cards, on the same board or another board. Returns the new list ID and the number of cards copied, with warnings if labels could not be preserved across boards.',
+        inputSchema: {
+          sourceListId: z.string().describe('ID of the list to duplicate'),
+          name: z.string().describe('Name of the new list'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board to create the copy on (defaults to the source list board)'),
+          position: z
+            .union([z.enum(['top', 'bottom']), z.number().positive()])
+            .optional()
+            .describe('Position of the new list: "top", "bottom", or a positive number'),
+        },
+      },
+      async ({ sourceListId, name, boardId, position }) => {
+        try {
+          const result = await this.trelloClient.duplicateList({
+            sourceListId,
+            name,
+            boardId,
+            position,
+          });
           return {
             content: [
               {
                 type: 'text' as const,
-                text: `Successfully set active board to "${board.name}" (${board.id})`,
+                text: JSON.stringify(
+                  {
+                    id: result.list.id,
+                    name: result.list.name,
+                    idBoard: result.list.idBoard,
+                    cardCount: result.cardCount,
+                    warnings: result.warnings,
+                  },
+                  null,
+                  2
+                ),
               },
             ],
           };
@@ -780,20 +1342,58 @@ class TrelloServer {
       }
     );
 
-    // List workspaces
+    // Move a list with its cards to another board
     this.server.registerTool(
-      'list_workspaces',
+      'move_list_to_board',
       {
-        title: 'List Workspaces',
+        title: 'Move List to Board',
         description:
-          'List workspaces the user has access to. If TRELLO_ALLOWED_WORKSPACES is configured, only allowed workspaces are returned.',
-        inputSchema: {},
+          'Move a list and all of its cards to another board. Labels and members may not transfer cleanly across boards; set remapLabels to reapply labels by name on the target board. Returns the moved list with any warnings.',
+        inputSchema: {
+          listId: z.string().describe('ID of the list to move'),
+          targetBoardId: z.string().describe('ID of the board to move the list to'),
+          position: z
+            .union([z.enum(['top', 'bottom']), z.number().positive()])
+            .optional()
+            .describe('Position on the target board: "top", "bottom", or a positive number'),
+          remapLabels: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe(
+              "Reapply each card's labels using the target board's labels of the same name (default: false)"
+            ),
+          concurrency: bulkConcurrencySchema,
+        },
       },
-      async () => {
+      async ({ listId, targetBoardId, position, remapLabels, concurrency }) => {
         try {
-          const workspaces = await this.trelloClient.listWorkspaces();
+          const result = await this.trelloClient.moveListToBoard({
+            listId,
+            targetBoardId,
+            position,
+            remapLabels,
+            concurrency,
+          });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(workspaces, null, 2) }],
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    id: result.list.id,
+                    name: result.list.name,
+                    idBoard: result.list.idBoard,
+                    sourceBoardId: result.sourceBoardId,
+                    cardCount: result.cardCount,
+                    relabelled: result.relabelled,
+                    warnings: result.warnings,
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
           };
         } catch (error) {
           return this.handleError(error);
@@ -801,43 +1401,2123 @@ class TrelloServer {
       }
     );
 
-    // Create a new board
+    // Archive a list
     this.server.registerTool(
-      'create_board',
+      'archive_list',
       {
-        title: 'Create Board',
-        description: 'Create a new Trello board optionally within a workspace',
+        title: 'Archive List',
+        description: 'Send a list to the archive on a specific board',
         inputSchema: {
-          name: z.string().describe('Name of the board'),
-          desc: z.string().optional().describe('Description of the board'),
-          idOrganization: z
+          boardId: z
             .string()
-            .min(1)
-            .optional()
-            .describe('Workspace ID to create the board in (uses active if not provided)'),
-          defaultLabels: z
-            .boolean()
             .optional()
-            .default(true)
-            .describe('Create default labels (true by default)'),
-          defaultLists: z
+            .describe('ID of the Trello board (uses default if not provided)'),
+          listId: z.string().describe('ID of the list to archive'),
+          dryRun: z
             .boolean()
             .optional()
-            .default(true)
-            .describe('Create default lists (true by default)'),
+            .default(false)
+            .describe('Preview what would happen without making any changes (default: false)'),
         },
       },
-      async ({ name, desc, idOrganization, defaultLabels, defaultLists }) => {
+      async ({ boardId, listId, dryRun }) => {
         try {
-          const board = await this.trelloClient.createBoard({
-            name,
-            desc,
-            idOrganization,
-            defaultLabels,
-            defaultLists,
+          if (dryRun) {
+            const target = await this.trelloClient.getList(listId);
+            const cards = await this.trelloClient.getCardsByList(listId, 'name');
+            return this.dryRunResponse(
+              `Would archive list "${target.name}" containing ${cards.length} open cards`,
+              target
+            );
+          }
+          const list = await this.trelloClient.archiveList(boardId, listId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Update a list
+    this.server.registerTool(
+      'update_list',
+      {
+        title: 'Update List',
+        description:
+          'Update a list name, archive state, subscription state, or board. Use update_list_position for moving a list within a board.',
+        inputSchema: {
+          listId: z.string().describe('ID of the Trello list to update'),
+          name: z.string().optional().describe('New name for the list'),
+          closed: z.boolean().optional().describe('Whether to close (archive) the list'),
+          subscribed: z
+            .boolean()
+            .optional()
+            .describe('Whether the authenticated user is subscribed to the list'),
+          idBoard: z.string().optional().describe('ID of a board to move the list to'),
+        },
+      },
+      async ({ listId, name, closed, subscribed, idBoard }) => {
+        try {
+          const params: {
+            name?: string;
+            closed?: boolean;
+            subscribed?: boolean;
+            idBoard?: string;
+          } = {};
+          if (name !== undefined) params.name = name;
+          if (closed !== undefined) params.closed = closed;
+          if (subscribed !== undefined) params.subscribed = subscribed;
+          if (idBoard !== undefined) params.idBoard = idBoard;
+          if (Object.keys(params).length === 0) {
+            throw new McpError(
+              ErrorCode.InvalidParams,
+              'At least one of name, closed, subscribed, or idBoard must be provided'
+            );
+          }
+          const list = await this.trelloClient.updateList(listId, params);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Update list position
+    this.server.registerTool(
+      'update_list_position',
+      {
+        title: 'Update List Position',
+        description:
+          'Update the position of a list on the board. Trello uses fractional indexing: each list has a float position, and to place a list between two others, use the average of their positions (e.g., between pos 1024 and 2048, use 1536). Use "top"/"bottom" shortcuts to move to the edges.',
+        inputSchema: {
+          listId: z.string().describe('ID of the list to reposition'),
+          position: z
+            .string()
+            .refine(
+              (val) => {
+                if (val === 'top' || val === 'bottom') return true;
+                const num = Number(val);
+                return num > 0 && isFinite(num);
+              },
+              {
+                message: "Position must be 'top', 'bottom', or a positive finite numeric string.",
+              }
+            )
+            .describe(
+              'New position: "top" (move to leftmost), "bottom" (move to rightmost), or a numeric string (e.g. "1536"). To place between two lists, use the average of their pos values.'
+            ),
+        },
+      },
+      async ({ listId, position }) => {
+        try {
+          const parsedPosition =
+            position === 'top' || position === 'bottom' ? position : Number(position);
+          const list = await this.trelloClient.updateListPosition(listId, parsedPosition);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(list, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get cards assigned to current user
+    this.server.registerTool(
+      'get_my_cards',
+      {
+        title: 'Get My Cards',
+        description: 'Fetch all cards assigned to the current user',
+        inputSchema: {
+          labelFormat: labelFormatSchema,
+          full: fullDescriptionSchema,
+        },
+      },
+      async ({ labelFormat, full }) => {
+        try {
+          const cards = await this.trelloClient.getMyCards();
+          const formatted = cards.map(card =>
+            this.capDescription(labelFormat ? formatCardLabels(card, labelFormat) : card, full)
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(formatted, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Due-date report
+    this.server.registerTool(
+      'get_cards_due_report',
+      {
+        title: 'Get Cards Due Report',
+        description:
+          'Due-date report for a board, e.g. for weekly planning: open cards grouped into overdue, dueToday, dueThisWeek (the next six days), later, and noDueDate, each with a count and its cards, their lists, and any planned start date. Cards marked due-complete are left out.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          timezone: z
+            .string()
+            .optional()
+            .describe(
+              'IANA timezone that decides what "today" is, e.g. "Europe/Berlin" (default: the server timezone)'
+            ),
+        },
+      },
+      async ({ boardId, timezone }) => {
+        try {
+          const report = await this.trelloClient.getDueReport(boardId, timezone);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(report, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Cards coming due soon
+    this.server.registerTool(
+      'get_due_soon',
+      {
+        title: 'Get Due Soon',
+        description:
+          'List cards whose due date falls within the next withinHours, soonest first, excluding cards marked due-complete. Scans one board when boardId is given, otherwise the cards assigned to you across all boards.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board to scan (omit to scan cards assigned to you on all boards)'),
+          withinHours: z
+            .number()
+            .positive()
+            .optional()
+            .default(48)
+            .describe('Size of the look-ahead window in hours (default: 48)'),
+          includeOverdue: z
+            .boolean()
+            .optional()
+            .default(true)
+            .describe('Include cards whose due date has already passed (default: true)'),
+        },
+      },
+      async ({ boardId, withinHours, includeOverdue }) => {
+        try {
+          const cards = await this.trelloClient.getDueSoon({ boardId, withinHours, includeOverdue });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(cards, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Attach image to card (kept for backward compatibility)
+    this.server.registerTool(
+      'attach_image_to_card',
+      {
+        title: 'Attach Image to Card',
+        description: 'Attach an image to a card from a URL on a specific board',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the Trello board where the card exists (uses default if not provided)'
+            ),
+          cardId: z.string().describe('ID of the card to attach the image to'),
+          imageUrl: z.string().describe('URL of the image to attach'),
+          name: z
+            .string()
+            .optional()
+            .default('Image Attachment')
+            .describe('Optional name for the attachment (defaults to "Image Attachment")'),
+        },
+      },
+      async ({ boardId, cardId, imageUrl, name }) => {
+        try {
+          const attachment = await this.trelloClient.attachImageToCard(
+            boardId,
+            cardId,
+            imageUrl,
+            name
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Attach file to card (generic file attachment)
+    this.server.registerTool(
+      'attach_file_to_card',
+      {
+        title: 'Attach File to Card',
+        description: 'Attach any file to a card from a URL on a specific board',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the Trello board where the card exists (uses default if not provided)'
+            ),
+          cardId: z.string().describe('ID of the card to attach the file to'),
+          fileUrl: z.string().describe('URL of the file to attach'),
+          name: z
+            .string()
+            .optional()
+            .default('File Attachment')
+            .describe('Optional name for the attachment (defaults to "File Attachment")'),
+          mimeType: z
+            .string()
+            .optional()
+            .describe(
+              'Optional MIME type of the file (e.g., "application/pdf", "text/plain", "video/mp4")'
+            ),
+        },
+      },
+      async ({ boardId, cardId, fileUrl, name, mimeType }) => {
+        try {
+          const attachment = await this.trelloClient.attachFileToCard(
+            boardId,
+            cardId,
+            fileUrl,
+            name,
+            mimeType
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+          };
+        } catch (error) {
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: `Error: ${error instanceof Error ? error.message : 'Unknown error occurred'}`,
+              },
+            ],
+            isError: true,
+          };
+        }
+      }
+    );
+
+    // Attach arbitrary binary data to a card (base64 or data URL)
+    this.server.registerTool(
+      'attach_data_to_card',
+      {
+        title: 'Attach Data to Card',
+        description:
+          'Attach binary data (image, markdown, PDF, text, etc.) to a card from base64-encoded data or a data URL. Use this for any non-image content. For image/screenshot uploads with PNG defaults, see attach_image_data_to_card.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the Trello board where the card exists (uses default if not provided)'
+            ),
+          cardId: z.string().describe('ID of the card to attach the data to'),
+          data: z
+            .string()
+            .describe(
+              'Base64-encoded data or a data URL (e.g. data:text/markdown;base64,...). Any content type, not just images.'
+            ),
+          name: z
+            .string()
+            .optional()
+            .describe(
+              'Filename for the attachment, including extension (e.g. "notes.md", "report.pdf"). Defaults to "attachment-<timestamp>".'
+            ),
+          mimeType: z
+            .string()
+            .optional()
+            .describe(
+              'MIME type of the data (e.g. "text/markdown", "application/pdf", "image/png"). Recommended for correct rendering in Trello. If omitted, inferred from a data URL prefix or the filename extension; falls back to "application/octet-stream".'
+            ),
+        },
+      },
+      async ({ boardId, cardId, data, name, mimeType }) => {
+        try {
+          const attachment = await this.trelloClient.attachDataToCard(
+            boardId,
+            cardId,
+            data,
+            name,
+            mimeType
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Attach image data to card (image-flavored convenience over attach_data_to_card)
+    this.server.registerTool(
+      'attach_image_data_to_card',
+      {
+        title: 'Attach Image Data to Card',
+        description:
+          'Attach an image to a card from base64 data or a data URL. Image-flavored convenience over attach_data_to_card: defaults assume PNG when mimeType/name are omitted, suitable for screenshot pasting. For non-image content, use attach_data_to_card.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the Trello board where the card exists (uses default if not provided)'
+            ),
+          cardId: z.string().describe('ID of the card to attach the image to'),
+          imageData: z.string().describe('Base64 encoded image data or data URL (e.g., data:image/png;base64,...)'),
+          name: z
+            .string()
+            .optional()
+            .describe('Optional name for the attachment'),
+          mimeType: z
+            .string()
+            .optional()
+            .default('image/png')
+            .describe('Optional MIME type (default: image/png)'),
+        },
+      },
+      async ({ boardId, cardId, imageData, name, mimeType }) => {
+        try {
+          const attachment = await this.trelloClient.attachImageDataToCard(
+            boardId,
+            cardId,
+            imageData,
+            name,
+            mimeType
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Rename an attachment
+    this.server.registerTool(
+      'rename_attachment',
+      {
+        title: 'Rename Attachment',
+        description:
+          "Rename an attachment on a card. Only the display name changes; the URL or uploaded file is left as is. Trello may refuse to rename uploaded files, in which case the error says so.",
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the Trello board where the card exists (uses default if not provided)'
+            ),
+          cardId: z.string().describe('ID of the card the attachment is on'),
+          attachmentId: z.string().describe('ID of the attachment to rename'),
+          name: z.string().min(1).describe('New name for the attachment'),
+        },
+      },
+      async ({ boardId, cardId, attachmentId, name }) => {
+        try {
+          const attachment = await this.trelloClient.renameAttachment(
+            boardId,
+            cardId,
+            attachmentId,
+            name
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(attachment, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // List all boards
+    this.server.registerTool(
+      'list_boards',
+      {
+        title: 'List Boards',
+        description: 'List all boards the user has access to',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const boards = await this.trelloClient.listBoards();
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(boards, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Boards of another member
+    this.server.registerTool(
+      'get_member_boards',
+      {
+        title: 'Get Member Boards',
+        description:
+          'List the boards a given member (ID or username) belongs to, as far as your token can see, e.g. to review what a teammate has access to before assigning work.',
+        inputSchema: {
+          member: z.string().min(1).describe('Member ID or username (with or without @)'),
+          filter: z
+            .enum(['all', 'open', 'closed', 'members', 'organization', 'public', 'starred'])
+            .optional()
+            .describe('Which boards to include (Trello default: all)'),
+          fields: z
+            .string()
+            .optional()
+            .describe('Comma-separated board fields to return (e.g., "name,url,idOrganization")'),
+        },
+      },
+      async ({ member, filter, fields }) => {
+        try {
+          const boards = await this.trelloClient.getMemberBoards(member, { filter, fields });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(boards, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Find a board by name
+    this.server.registerTool(
+      'get_board_by_name',
+      {
+        title: 'Get Board by Name',
+        description:
+          'Find a board by name (case-insensitive). Boards with the same name in different workspaces (e.g. several "Roadmap" boards) can be told apart with workspace; if the name is still ambiguous, returns the candidates with their workspace names instead of picking one.',
+        inputSchema: {
+          name: z.string().min(1).describe('Board name'),
+          workspace: z
+            .string()
+            .optional()
+            .describe('Workspace ID, display name, or short name to search within'),
+        },
+      },
+      async ({ name, workspace }) => {
+        try {
+          const result = await this.trelloClient.getBoardByName(name, workspace);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Archive or reopen a board
+    this.server.registerTool(
+      'set_board_closed',
+      {
+        title: 'Set Board Closed',
+        description:
+          'Archive (closed: true) or reopen (closed: false) a whole board. Archiving is reversible. Closing requires confirm: true. If the board was the default board, the default is cleared.',
+        inputSchema: {
+          boardId: z.string().describe('ID of the board to archive or reopen'),
+          closed: z.boolean().describe('true to archive the board, false to reopen it'),
+          confirm: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Must be true to archive a board (default: false)'),
+        },
+      },
+      async ({ boardId, closed, confirm }) => {
+        try {
+          if (closed && !confirm) {
+            throw new McpError(
+              ErrorCode.InvalidParams,
+              `Archiving board ${boardId} requires confirm: true`
+            );
+          }
+          const { board, clearedDefault } = await this.trelloClient.setBoardClosed(boardId, closed);
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    id: board.id,
+                    name: board.name,
+                    closed: board.closed,
+                    ...(clearedDefault && {
+                      warning: `Board ${board.id} was the default board; the default has been cleared. Use set_active_board to choose another.`,
+                    }),
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Set active board
+    this.server.registerTool(
+      'set_active_board',
+      {
+        title: 'Set Active Board',
+        description: 'Set the active board for future operations',
+        inputSchema: {
+          boardId: z.string().describe('ID of the board to set as active'),
+        },
+      },
+      async ({ boardId }) => {
+        try {
+          const board = await this.trelloClient.setActiveBoard(boardId);
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: `Successfully set active board to "${board.name}" (${board.id})`,
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Session-wide output size
+    this.server.registerTool(
+      'set_output_verbosity',
+      {
+        title: 'Set Output Verbosity',
+        description:
+          'Control how much every read tool (get_*, list_*, find_*, search_*) returns for the rest of the session. "compact" drops nulls, empty arrays and rendering fields such as badges, prefs and cover, and skips indentation; "full" (the default) returns everything.',
+        inputSchema: {
+          verbosity: z.enum(OUTPUT_VERBOSITIES).describe('"compact" or "full"'),
+        },
+      },
+      async ({ verbosity }) => {
+        const previous = this.outputVerbosity;
+        this.outputVerbosity = verbosity;
+        return {
+          content: [
+            {
+              type: 'text' as const,
+              text: JSON.stringify({ verbosity, previous }, null, 2),
+            },
+          ],
+        };
+      }
+    );
+
+    // Authenticated member
+    this.server.registerTool(
+      'whoami',
+      {
+        title: 'Who Am I',
+        description:
+          'Get the Trello member the API token belongs to (id, username, full name, email). Use the id for "assign to me" style requests.',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const member = await this.trelloClient.whoami();
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(member, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Notifications for the authenticated member
+    this.server.registerTool(
+      'get_notifications',
+      {
+        title: 'Get Notifications',
+        description:
+          'List your recent Trello notifications (mentions, due dates, being added to cards, ...) newest first, each as { id, type, date, card, board, unread }. Use it to summarize what needs your attention.',
+        inputSchema: {
+          types: z
+            .array(z.string().min(1))
+            .optional()
+            .describe(
+              'Only these notification types, e.g. ["mentionedOnCard", "cardDueSoon", "addedToCard"]'
+            ),
+          readFilter: z
+            .enum(['all', 'unread'])
+            .optional()
+            .default('all')
+            .describe('Return all notifications or only unread ones (default: all)'),
+          limit: z
+            .number()
+            .int()
+            .min(1)
+            .max(1000)
+            .optional()
+            .default(50)
+            .describe('Maximum number of notifications to return (default: 50, max: 1000)'),
+        },
+      },
+      async ({ types, readFilter, limit }) => {
+        try {
+          const notifications = await this.trelloClient.getNotifications({
+            types,
+            readFilter,
+            limit,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(notifications, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'mark_notification_read',
+      {
+        title: 'Mark Notification Read',
+        description: 'Mark one of your notifications as read',
+        inputSchema: {
+          notificationId: z.string().describe('ID of the notification to mark as read'),
+        },
+      },
+      async ({ notificationId }) => {
+        try {
+          const notification = await this.trelloClient.markNotificationRead(notificationId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(notification, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'mark_all_notifications_read',
+      {
+        title: 'Mark All Notifications Read',
+        description:
+          'Mark all of your unread notifications as read, e.g. after summarizing them, or only the notifications listed in ids. Returns how many were marked.',
+        inputSchema: {
+          ids: z
+            .array(z.string())
+            .optional()
+            .describe('Only mark these notification IDs as read (default: every unread notification)'),
+          concurrency: bulkConcurrencySchema,
+        },
+      },
+      async ({ ids, concurrency }) => {
+        try {
+          const result = await this.trelloClient.markNotificationsRead({ ids, concurrency });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Set default board
+    this.server.registerTool(
+      'set_default_board',
+      {
+        title: 'Set Default Board',
+        description:
+          'Change the board used when tools are called without a boardId, e.g. "work on the Marketing board for now". Kept in memory only unless persist is true.',
+        inputSchema: {
+          boardId: z.string().optional().describe('ID of the board to use by default'),
+          boardName: z
+            .string()
+            .optional()
+            .describe('Name of the board to use by default (case-insensitive, must be unique)'),
+          persist: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Also save the default to the config file so it survives restarts'),
+        },
+      },
+      async ({ boardId, boardName, persist }) => {
+        try {
+          const board = await this.trelloClient.setDefaultBoard({ boardId, boardName, persist });
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  { id: board.id, name: board.name, url: board.url, persisted: persist },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get default board
+    this.server.registerTool(
+      'get_default_board',
+      {
+        title: 'Get Default Board',
+        description: 'Get the board used when tools are called without a boardId',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const boardId = this.trelloClient.effectiveDefaultBoardId;
+          if (!boardId) {
+            return {
+              content: [{ type: 'text' as const, text: 'No default board set' }],
+              isError: true,
+            };
+          }
+          const board = await this.trelloClient.getBoardById(boardId);
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify({ id: board.id, name: board.name, url: board.url }, null, 2),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Set the default list of a board
+    this.server.registerTool(
+      'set_default_list',
+      {
+        title: 'Set Default List',
+        description:
+          'Choose the list new cards go to on its board when add_card_to_list is called without a listId, e.g. "cards go to Inbox by default". Kept in memory only unless persist is true.',
+        inputSchema: {
+          listId: z.string().describe('ID of the list to use by default'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board the list must be on; rejects lists on other boards'),
+          persist: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Also save the default to the config file so it survives restarts'),
+        },
+      },
+      async ({ listId, boardId, persist }) => {
+        try {
+          const list = await this.trelloClient.setDefaultList({ listId, boardId, persist });
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  { boardId: list.idBoard, listId: list.id, name: list.name, persisted: persist },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get the default list of a board
+    this.server.registerTool(
+      'get_default_list',
+      {
+        title: 'Get Default List',
+        description:
+          'Get the list new cards go to on a board when no listId is given, with a warning if it has since been archived or deleted',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+        },
+      },
+      async ({ boardId }) => {
+        try {
+          const result = await this.trelloClient.getDefaultList(boardId);
+          if (!result) {
+            return {
+              content: [{ type: 'text' as const, text: 'No default list set for this board' }],
+              isError: true,
+            };
+          }
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // List workspaces
+    this.server.registerTool(
+      'list_workspaces',
+      {
+        title: 'List Workspaces',
+        description:
+          'List workspaces the user has access to as { id, displayName, name }. If TRELLO_ALLOWED_WORKSPACES is configured, only allowed workspaces are returned.',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const workspaces = await this.trelloClient.listWorkspaces();
+          const summaries = workspaces.map(({ id, displayName, name }) => ({
+            id,
+            displayName,
+            name,
+          }));
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(summaries, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Create a new board
+    this.server.registerTool(
+      'create_board',
+      {
+        title: 'Create Board',
+        description: 'Create a new Trello board optionally within a workspace',
+        inputSchema: {
+          name: z.string().describe('Name of the board'),
+          desc: z.string().optional().describe('Description of the board'),
+          idOrganization: z
+            .string()
+            .min(1)
+            .optional()
+            .describe('Workspace ID to create the board in (uses active if not provided)'),
+          workspaceName: z
+            .string()
+            .min(1)
+            .optional()
+            .describe(
+              'Workspace display name or short name to create the board in, resolved via list_workspaces (ignored when idOrganization is set)'
+            ),
+          defaultLabels: z
+            .boolean()
+            .optional()
+            .describe("Create Trello's default labels (default: true, or false when labels is given)"),
+          defaultLists: z
+            .boolean()
+            .optional()
+            .default(true)
+            .describe('Create default lists (true by default)'),
+          labels: z
+            .array(
+              z.object({
+                name: z.string().describe('Label name, e.g. "bug"'),
+                color: z.string().optional().describe('Label color, e.g. "red"'),
+              })
+            )
+            .optional()
+            .describe(
+              'Labels to create on the new board, to standardize label taxonomy. Created labels are returned with the board.'
+            ),
+        },
+      },
+      async ({
+        name,
+        desc,
+        idOrganization,
+        workspaceName,
+        defaultLabels,
+        defaultLists,
+        labels,
+      }) => {
+        try {
+          const board = await this.trelloClient.createBoard({
+            name,
+            desc,
+            idOrganization,
+            workspaceName,
+            defaultLabels: defaultLabels ?? !(labels && labels.length > 0),
+            defaultLists,
+            labels,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(board, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Copy a board
+    this.server.registerTool(
+      'copy_board',
+      {
+        title: 'Copy Board',
+        description:
+          'Create a new board from an existing one, e.g. to start a sprint from the last sprint layout. Trello copies lists and labels (and cards unless keepCards is false) but not board members.',
+        inputSchema: {
+          sourceBoardId: z.string().describe('ID of the board to copy'),
+          name: z.string().describe('Name of the new board'),
+          idOrganization: z
+            .string()
+            .min(1)
+            .optional()
+            .describe('Workspace ID to create the board in (uses active if not provided)'),
+          keepCards: z
+            .boolean()
+            .optional()
+            .default(true)
+            .describe('Copy the source board cards too (default: true)'),
+        },
+      },
+      async ({ sourceBoardId, name, idOrganization, keepCards }) => {
+        try {
+          const board = await this.trelloClient.copyBoard({
+            sourceBoardId,
+            name,
+            idOrganization,
+            keepCards,
+          });
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    id: board.id,
+                    name: board.name,
+                    url: board.url,
+                    warnings: [
+                      'Board members are not copied; invite them to the new board separately.',
+                    ],
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // List template boards
+    this.server.registerTool(
+      'list_board_templates',
+      {
+        title: 'List Board Templates',
+        description:
+          "List open boards marked as templates that the token can see. Trello's public template gallery is not available through the API.",
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const templates = await this.trelloClient.listBoardTemplates();
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(templates, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Create a board from a template
+    this.server.registerTool(
+      'create_board_from_template',
+      {
+        title: 'Create Board From Template',
+        description:
+          'Create a new board from a template board (see list_board_templates). Fails if the source board is not a template; use copy_board for ordinary boards.',
+        inputSchema: {
+          templateBoardId: z.string().describe('ID of the template board'),
+          name: z.string().describe('Name of the new board'),
+          idOrganization: z
+            .string()
+            .min(1)
+            .optional()
+            .describe('Workspace ID to create the board in (uses active if not provided)'),
+          keepCards: z
+            .boolean()
+            .optional()
+            .default(true)
+            .describe('Copy the template cards too (default: true)'),
+        },
+      },
+      async ({ templateBoardId, name, idOrganization, keepCards }) => {
+        try {
+          const board = await this.trelloClient.createBoardFromTemplate({
+            templateBoardId,
+            name,
+            idOrganization,
+            keepCards,
+          });
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    id: board.id,
+                    name: board.name,
+                    url: board.url,
+                    templateBoardId,
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Read board preferences
+    this.server.registerTool(
+      'get_board_prefs',
+      {
+        title: 'Get Board Preferences',
+        description:
+          "Get a board's current preferences (visibility, voting, commenting, card covers and background), e.g. to check settings before or after set_board_preferences",
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+        },
+      },
+      async ({ boardId }) => {
+        try {
+          const prefs = await this.trelloClient.getBoardPreferences(boardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(prefs, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Email-to-board address
+    this.server.registerTool(
+      'get_board_email_address',
+      {
+        title: 'Get Board Email Address',
+        description:
+          "Get the board's email-to-board address, for integrations that cannot call the API. Emails sent to it become cards in defaultList at position (set in the board menu under Email-to-board settings). The address belongs to the token's member and should be kept private.",
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+        },
+      },
+      async ({ boardId }) => {
+        try {
+          const address = await this.trelloClient.getBoardEmailAddress(boardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(address, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Update board preferences
+    this.server.registerTool(
+      'set_board_preferences',
+      {
+        title: 'Set Board Preferences',
+        description:
+          'Update board preferences: background, card cover visibility, voting and commenting permissions, and visibility (permissionLevel). Only the provided preferences are changed. Returns the updated prefs.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          background: z
+            .union([
+              z.enum(TrelloClient.BOARD_BACKGROUND_COLORS),
+              z.string().regex(/^[0-9a-f]{24}$/, 'background must be a color name or a custom background ID'),
+            ])
+            .optional()
+            .describe(
+              `Background color (${TrelloClient.BOARD_BACKGROUND_COLORS.join(', ')}) or the ID of a custom/uploaded background image`
+            ),
+          cardCovers: z.boolean().optional().describe('Whether card cover images are shown on the board'),
+          voting: z
+            .enum(TrelloClient.BOARD_AUDIENCE_LEVELS)
+            .optional()
+            .describe('Who can vote on cards'),
+          comments: z
+            .enum(TrelloClient.BOARD_AUDIENCE_LEVELS)
+            .optional()
+            .describe('Who can comment on cards'),
+          permissionLevel: z
+            .enum(TrelloClient.BOARD_PERMISSION_LEVELS)
+            .optional()
+            .describe('Board visibility'),
+        },
+      },
+      async ({ boardId, background, cardCovers, voting, comments, permissionLevel }) => {
+        try {
+          const prefs = await this.trelloClient.updateBoardPreferences(boardId, {
+            background,
+            cardCovers,
+            voting,
+            comments,
+            permissionLevel,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(prefs, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Power-Ups
+    this.server.registerTool(
+      'enable_power_up',
+      {
+        title: 'Enable Power-Up',
+        description:
+          'Enable a Power-Up on a board, e.g. Custom Fields before using the custom field tools. Returns the Power-Ups now enabled on the board.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          powerUp: z
+            .string()
+            .describe('Plugin ID, or one of the well-known names "custom-fields", "voting", "calendar"'),
+        },
+      },
+      async ({ boardId, powerUp }) => {
+        try {
+          const plugins = await this.trelloClient.enablePowerUp(boardId, powerUp);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(plugins, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'disable_power_up',
+      {
+        title: 'Disable Power-Up',
+        description:
+          'Disable a Power-Up on a board. Returns the Power-Ups now enabled on the board.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          powerUp: z
+            .string()
+            .describe('Plugin ID, or one of the well-known names "custom-fields", "voting", "calendar"'),
+        },
+      },
+      async ({ boardId, powerUp }) => {
+        try {
+          const plugins = await this.trelloClient.disablePowerUp(boardId, powerUp);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(plugins, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Set active workspace
+    this.server.registerTool(
+      'set_active_workspace',
+      {
+        title: 'Set Active Workspace',
+        description: 'Set the active workspace for future operations',
+        inputSchema: {
+          workspaceId: z.string().describe('ID of the workspace to set as active'),
+        },
+      },
+      async ({ workspaceId }) => {
+        try {
+          const workspace = await this.trelloClient.setActiveWorkspace(workspaceId);
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: `Successfully set active workspace to "${workspace.displayName}" (${workspace.id})`,
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // List boards in workspace
+    this.server.registerTool(
+      'list_boards_in_workspace',
+      {
+        title: 'List Boards in Workspace',
+        description: 'List all boards in a specific workspace',
+        inputSchema: {
+          workspaceId: z.string().describe('ID of the workspace to list boards from'),
+        },
+      },
+      async ({ workspaceId }) => {
+        try {
+          const boards = await this.trelloClient.listBoardsInWorkspace(workspaceId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(boards, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get active board info
+    this.server.registerTool(
+      'get_active_board_info',
+      {
+        title: 'Get Active Board Info',
+        description: 'Get information about the currently active board',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const boardId = this.trelloClient.activeBoardId;
+          if (!boardId) {
+            return {
+              content: [{ type: 'text' as const, text: 'No active board set' }],
+              isError: true,
+            };
+          }
+          const board = await this.trelloClient.getBoardById(boardId);
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    ...board,
+                    isActive: true,
+                    activeWorkspaceId: this.trelloClient.activeWorkspaceId || 'Not set',
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get card details
+    this.server.registerTool(
+      'get_card',
+      {
+        title: 'Get Card',
+        description:
+          'Get detailed information about a specific Trello card. Members, checklists, attachments, and custom fields are included by default; set the include flags to false to leave them out and keep the response small.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card to fetch'),
+          includeMarkdown: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Whether to return card description in markdown format (default: false)'),
+          includeMembers: z
+            .boolean()
+            .optional()
+            .describe('Include card members and voters (default: true)'),
+          includeChecklists: z
+            .boolean()
+            .optional()
+            .describe('Include checklists and their items (default: true)'),
+          includeAttachments: z
+            .boolean()
+            .optional()
+            .describe('Include attachments (default: true)'),
+          includeCustomFields: z
+            .boolean()
+            .optional()
+            .describe('Include custom field items (default: true)'),
+          noCache: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Skip the ETag cache and always fetch a fresh copy (default: false)'),
+          labelFormat: labelFormatSchema,
+          full: fullDescriptionSchema,
+          includeNames: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe(
+              'Add listName and boardName next to idList and idBoard (default: false). Ignored with includeMarkdown.'
+            ),
+        },
+      },
+      async ({
+        cardId,
+        includeMarkdown,
+        includeMembers,
+        includeChecklists,
+        includeAttachments,
+        includeCustomFields,
+        noCache,
+        labelFormat,
+        full,
+        includeNames,
+      }) => {
+        try {
+          const card = await this.trelloClient.getCard(
+            cardId,
+            includeMarkdown,
+            {
+              members: includeMembers,
+              checklists: includeChecklists,
+              attachments: includeAttachments,
+              customFields: includeCustomFields,
+            },
+            { noCache }
+          );
+          const named =
+            includeNames && typeof card !== 'string'
+              ? { ...card, ...(await this.trelloClient.getCardLocationNames(card)) }
+              : card;
+          const formatted =
+            typeof named === 'string'
+              ? named
+              : this.capDescription(
+                  labelFormat ? formatCardLabels(named, labelFormat) : named,
+                  full
+                );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(formatted, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Just the description of a card
+    this.server.registerTool(
+      'get_card_description',
+      {
+        title: 'Get Card Description',
+        description:
+          "Read only a card's name and description markdown, without labels, members or checklists. The description is an empty string when the card has none.",
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+        },
+      },
+      async ({ cardId }) => {
+        try {
+          const card = await this.trelloClient.getCardDescription(cardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Compact label list for a card
+    this.server.registerTool(
+      'list_card_labels_summary',
+      {
+        title: 'List Card Labels Summary',
+        description:
+          'Just the labels on a card as a compact array of "color:name" strings (an unnamed label is just its color). Returns [] for an unlabeled card.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+        },
+      },
+      async ({ cardId }) => {
+        try {
+          const labels = await this.trelloClient.getCardLabelsSummary(cardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(labels) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Assignees of a card with their names
+    this.server.registerTool(
+      'get_card_member_details',
+      {
+        title: 'Get Card Member Details',
+        description:
+          "List a card's assigned members as { id, username, fullName, avatarUrl }, e.g. to show assignee names without fetching the whole board's members. Members whose lookup failed are listed under failures.",
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+        },
+      },
+      async ({ cardId }) => {
+        try {
+          const result = await this.trelloClient.getCardMemberDetails(cardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get card details from a pasted URL or short link
+    this.server.registerTool(
+      'get_card_by_short_link',
+      {
+        title: 'Get Card by Short Link',
+        description:
+          'Get detailed information about a card from a Trello card URL (e.g. https://trello.com/c/AbCdEf12/42-title) or its 8-character short link',
+        inputSchema: {
+          url: z.string().describe('Trello card URL or short link code'),
+          includeMarkdown: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Whether to return card description in markdown format (default: false)'),
+        },
+      },
+      async ({ url, includeMarkdown }) => {
+        try {
+          const card = await this.trelloClient.getCardByShortLink(url, includeMarkdown);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get a card by its number on the board
+    this.server.registerTool(
+      'get_card_by_short_id',
+      {
+        title: 'Get Card by Short ID',
+        description:
+          'Get detailed information about a card from its number on a board, the way people refer to cards in conversation ("card 42", "#42")',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          shortId: z
+            .union([
+              z.number().int().positive(),
+              z.string().regex(/^#?\d+$/, 'expected a card number such as 42 or "#42"'),
+            ])
+            .describe('Card number on the board, e.g. 42 or "#42"'),
+          includeMarkdown: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Whether to return card description in markdown format (default: false)'),
+        },
+      },
+      async ({ boardId, shortId, includeMarkdown }) => {
+        try {
+          const card = await this.trelloClient.getCardByShortId(
+            boardId,
+            typeof shortId === 'number' ? shortId : Number(shortId.replace('#', '')),
+            includeMarkdown
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Add a comment to a card
+    this.server.registerTool(
+      'add_comment',
+      {
+        title: 'Add Comment to Card',
+        description:
+          'Add the given text as a new comment to the given card. Comments are limited to 16,384 characters; longer text is rejected unless autoSplit is set.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card to comment on'),
+          text: z.string().describe('The text of the comment to add'),
+          mentionMemberIds: z
+            .array(z.string())
+            .optional()
+            .describe(
+              'IDs of board members to @mention. They are resolved to @username tokens so Trello notifies them; members without a username are skipped and reported.'
+            ),
+          autoSplit: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe(
+              'Post text longer than the 16,384-character limit as numbered comments instead of rejecting it; returns the IDs of every comment posted, and if a part fails, which one and the IDs of the parts posted before it (default: false)'
+            ),
+        },
+      },
+      async ({ cardId, text, mentionMemberIds, autoSplit }) => {
+        try {
+          if (autoSplit) {
+            const result = await this.trelloClient.addCommentInParts(
+              cardId,
+              text,
+              mentionMemberIds
+            );
+            return {
+              content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+              ...(result.failure && { isError: true }),
+            };
+          }
+          if (mentionMemberIds && mentionMemberIds.length > 0) {
+            const result = await this.trelloClient.addCommentWithMentions(
+              cardId,
+              text,
+              mentionMemberIds
+            );
+            return {
+              content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+            };
+          }
+          const comment = await this.trelloClient.addCommentToCard(cardId, text);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(comment, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Post one comment to many cards
+    this.server.registerTool(
+      'comment_on_cards',
+      {
+        title: 'Comment on Cards',
+        description:
+          'Post the same comment to several cards, e.g. "moved to sprint 12". Returns success or failure and the new comment ID for each card.',
+        inputSchema: {
+          cardIds: z.array(z.string()).min(1).describe('IDs of the cards to comment on'),
+          text: z.string().describe('The text of the comment to add'),
+          boardId: z
+            .string()
+            .optional()
+            .describe(
+              'ID of the board whose members mentionMemberIds are resolved against (uses default if not provided)'
+            ),
+          mentionMemberIds: z
+            .array(z.string())
+            .optional()
+            .describe(
+              'IDs of board members to @mention in every comment; members without a username are skipped and reported'
+            ),
+          concurrency: bulkConcurrencySchema,
+        },
+      },
+      async ({ cardIds, text, boardId, mentionMemberIds, concurrency }) => {
+        try {
+          const result = await this.trelloClient.commentOnCards({
+            cardIds,
+            text,
+            boardId,
+            mentionMemberIds,
+            concurrency,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Update a comment to a card
+    this.server.registerTool(
+      'update_comment',
+      {
+        title: 'Update Comment on Card',
+        description: 'Update the given comment with the new text',
+        inputSchema: {
+          commentId: z.string().describe('ID of the comment to change'),
+          text: z.string().describe('The new text of the comment'),
+        },
+      },
+      async ({ commentId, text }) => {
+        try {
+          const success = await this.trelloClient.updateCommentOnCard(commentId, text);
+          return {
+            content: [{ type: 'text' as const, text: success ? 'success' : 'failure' }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Delete a comment from a card
+    this.server.registerTool(
+      'delete_comment',
+      {
+        title: 'Delete Comment from Card',
+        description: 'Delete a comment from a Trello card',
+        inputSchema: {
+          commentId: z.string().describe('ID of the comment to delete'),
+          dryRun: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Preview what would happen without making any changes (default: false)'),
+        },
+      },
+      async ({ commentId, dryRun }) => {
+        try {
+          if (dryRun) {
+            const target = await this.trelloClient.getAction(commentId);
+            const onCard = target.data.card ? ` on card "${target.data.card.name}"` : '';
+            return this.dryRunResponse(
+              `Would delete comment by @${target.memberCreator.username}${onCard}`,
+              target
+            );
+          }
+          const success = await this.trelloClient.deleteCommentFromCard(commentId);
+          return {
+            content: [{ type: 'text' as const, text: success ? 'success' : 'failure' }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Comment reactions
+    this.server.registerTool(
+      'add_comment_reaction',
+      {
+        title: 'Add Comment Reaction',
+        description:
+          'React to a comment with an emoji. Returns the comment reaction summary (count per emoji).',
+        inputSchema: {
+          commentId: z.string().describe('ID of the comment (its action ID)'),
+          emoji: z.string().describe('Emoji short name, e.g. "thumbsup" or ":tada:"'),
+        },
+      },
+      async ({ commentId, emoji }) => {
+        try {
+          const reactions = await this.trelloClient.addCommentReaction(commentId, emoji);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(reactions, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'remove_comment_reaction',
+      {
+        title: 'Remove Comment Reaction',
+        description:
+          'Remove your emoji reaction from a comment. Returns the comment reaction summary (count per emoji).',
+        inputSchema: {
+          commentId: z.string().describe('ID of the comment (its action ID)'),
+          emoji: z.string().describe('Emoji short name, e.g. "thumbsup" or ":tada:"'),
+        },
+      },
+      async ({ commentId, emoji }) => {
+        try {
+          const reactions = await this.trelloClient.removeCommentReaction(commentId, emoji);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(reactions, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Get comments from a card
+    this.server.registerTool(
+      'get_card_comments',
+      {
+        title: 'Get Card Comments',
+        description:
+          'Retrieve comments from a specific Trello card, newest first. Returns { items, nextCursor }; pass nextCursor back as cursor to fetch older comments.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card to get comments from'),
+          limit: z
+            .number()
+            .optional()
+            .default(100)
+            .describe('Maximum number of comments to retrieve (default: 100)'),
+          cursor: z
+            .string()
+            .optional()
+            .describe('nextCursor from a previous response, to fetch the following page'),
+        },
+      },
+      async ({ cardId, limit, cursor }) => {
+        try {
+          const page = await fetchPage(limit, cursor, before =>
+            this.trelloClient.getCardComments(cardId, limit, before)
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(page, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Checklist tools
+    this.server.registerTool(
+      'create_checklist',
+      {
+        title: 'Create Checklist',
+        description: 'Create a new checklist',
+        inputSchema: {
+          name: z.string().describe('Name of the checklist to create'),
+          cardId: z.string().describe('ID of the Trello card'),
+        },
+      },
+      async ({ name, cardId }) => {
+        try {
+          const items = await this.trelloClient.createChecklist(name, cardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(items, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Checklist tools
+    this.server.registerTool(
+      'get_card_checklists',
+      {
+        title: 'Get Card Checklists',
+        description:
+          'Get every checklist on a card with all items, their IDs, and completion percentage in one call. Use the returned IDs with update_checklist_item, update_checklist_item_position, or delete_checklist_item.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+          fields: z
+            .string()
+            .optional()
+            .describe('Comma-separated checklist fields to return (e.g., "name,pos"). Omit for all fields.'),
+        },
+      },
+      async ({ cardId, fields }) => {
+        try {
+          const checklists = await this.trelloClient.getCardChecklists(cardId, fields);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(checklists, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'get_checklist_items',
+      {
+        title: 'Get Checklist Items',
+        description: 'Get all items from a checklist by name',
+        inputSchema: {
+          name: z.string().describe('Name of the checklist to retrieve items from'),
+          cardId: z
+            .string()
+            .optional()
+            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          state: checkItemStateSchema,
+        },
+      },
+      async ({ name, cardId, boardId, state }) => {
+        try {
+          const items = await this.trelloClient.getChecklistItems(name, cardId, boardId);
+          const result = state === 'all' ? items : filterCheckListItems(items, state);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'add_checklist_item',
+      {
+        title: 'Add Checklist Item',
+        description: 'Add a new item to a checklist',
+        inputSchema: {
+          text: z.string().describe('Text content of the checklist item'),
+          checkListName: z.string().describe('Name of the checklist to add the item to'),
+          cardId: z
+            .string()
+            .optional()
+            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+        },
+      },
+      async ({ text, checkListName, cardId, boardId }) => {
+        try {
+          const item = await this.trelloClient.addChecklistItem(text, checkListName, cardId, boardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(item, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'find_checklist_items_by_description',
+      {
+        title: 'Find Checklist Items by Description',
+        description:
+          'Search for checklist items containing specific text (case-insensitive), on one card or across a board. Each match includes its checklist name, card ID, and completion state.',
+        inputSchema: {
+          description: z.string().describe('Text to search for in checklist item descriptions'),
+          cardId: z
+            .string()
+            .optional()
+            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          regex: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe(
+              'Treat description as a case-insensitive regular expression (max 200 characters, no nested quantifiers)'
+            ),
+        },
+      },
+      async ({ description, cardId, boardId, regex }) => {
+        try {
+          const items = await this.trelloClient.findChecklistItemsByDescription(
+            description,
+            cardId,
+            boardId,
+            { regex }
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(items, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'get_acceptance_criteria',
+      {
+        title: 'Get Acceptance Criteria',
+        description: 'Get all items from the "Acceptance Criteria" checklist',
+        inputSchema: {
+          cardId: z
+            .string()
+            .optional()
+            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          state: checkItemStateSchema,
+        },
+      },
+      async ({ cardId, boardId, state }) => {
+        try {
+          const items = await this.trelloClient.getAcceptanceCriteria(cardId, boardId);
+          const result = state === 'all' ? items : filterCheckListItems(items, state);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'get_checklist_by_name',
+      {
+        title: 'Get Checklist by Name',
+        description:
+          'Get a complete checklist with all its items and completion percentage. With fuzzy, matches any checklist whose name contains the text and returns { matches } when several do.',
+        inputSchema: {
+          name: z.string().describe('Name of the checklist to retrieve'),
+          cardId: z
+            .string()
+            .optional()
+            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          fuzzy: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe(
+              'Match checklists whose name contains the text, case-insensitively (default: false, exact name)'
+            ),
+        },
+      },
+      async ({ name, cardId, boardId, fuzzy }) => {
+        try {
+          if (fuzzy) {
+            const matches = await this.trelloClient.findChecklistsByName(name, cardId, boardId);
+            if (matches.length === 0) {
+              return {
+                content: [{ type: 'text' as const, text: `No checklist matching "${name}" found` }],
+                isError: true,
+              };
+            }
+            const result = matches.length === 1 ? matches[0] : { matches };
+            return {
+              content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+            };
+          }
+          const checklist = await this.trelloClient.getChecklistByName(name, cardId, boardId);
+          if (!checklist) {
+            return {
+              content: [{ type: 'text' as const, text: `Checklist "${name}" not found` }],
+              isError: true,
+            };
+          }
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(checklist, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'update_checklist_item',
+      {
+        title: 'Update Checklist Item',
+        description: 'Update a checklist item name, state, position, due date, reminder, or assigned member',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card containing the checklist item'),
+          checkItemId: z.string().describe('ID of the checklist item to update'),
+          state: z
+            .enum(['complete', 'incomplete'])
+            .optional()
+            .describe('New state for the checklist item'),
+          name: z.string().optional().describe('New text for the checklist item'),
+          pos: z
+            .union([z.number(), z.enum(['top', 'bottom'])])
+            .optional()
+            .describe('New position for the checklist item'),
+          due: z
+            .string()
+            .nullable()
+            .optional()
+            .describe('New due date for the checklist item in ISO 8601 format, or null to clear it'),
+          dueReminder: z
+            .number()
+            .nullable()
+            .optional()
+            .describe('Reminder offset in minutes before due date, or null to clear it'),
+          idMember: z
+            .string()
+            .nullable()
+            .optional()
+            .describe('Member ID to assign to the checklist item, or null to clear it'),
+        },
+      },
+      async ({ cardId, checkItemId, name, state, pos, due, dueReminder, idMember }) => {
+        try {
+          const item = await this.trelloClient.updateChecklistItem(cardId, checkItemId, {
+            name,
+            state,
+            pos,
+            due,
+            dueReminder,
+            idMember,
           });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(board, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(item, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -845,26 +3525,42 @@ class TrelloServer {
       }
     );
 
-    // Set active workspace
     this.server.registerTool(
-      'set_active_workspace',
+      'update_checklist_item_position',
       {
-        title: 'Set Active Workspace',
-        description: 'Set the active workspace for future operations',
+        title: 'Update Checklist Item Position',
+        description:
+          'Reorder a checklist item on a card. Identify the item by checkItemId, or by itemText (optionally scoped with checklistName); ambiguous text matches are rejected.',
         inputSchema: {
-          workspaceId: z.string().describe('ID of the workspace to set as active'),
+          cardId: z.string().describe('ID of the card containing the checklist item'),
+          checkItemId: z
+            .string()
+            .optional()
+            .describe('ID of the checklist item to move (alternative to itemText)'),
+          itemText: z
+            .string()
+            .optional()
+            .describe('Exact text of the checklist item to move (case-insensitive)'),
+          checklistName: z
+            .string()
+            .optional()
+            .describe('Name of the checklist to search for itemText (recommended to avoid ambiguity)'),
+          position: z
+            .union([z.number().positive(), z.enum(['top', 'bottom'])])
+            .describe('New position: "top", "bottom", or a positive number'),
         },
       },
-      async ({ workspaceId }) => {
+      async ({ cardId, checkItemId, itemText, checklistName, position }) => {
         try {
-          const workspace = await this.trelloClient.setActiveWorkspace(workspaceId);
+          const item = await this.trelloClient.updateChecklistItemPosition({
+            cardId,
+            checkItemId,
+            itemText,
+            checklistName,
+            position,
+          });
           return {
-            content: [
-              {
-                type: 'text' as const,
-                text: `Successfully set active workspace to "${workspace.displayName}" (${workspace.id})`,
-              },
-            ],
+            content: [{ type: 'text' as const, text: JSON.stringify(item, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -872,21 +3568,50 @@ class TrelloServer {
       }
     );
 
-    // List boards in workspace
     this.server.registerTool(
-      'list_boards_in_workspace',
+      'set_checklist_item_due',
       {
-        title: 'List Boards in Workspace',
-        description: 'List all boards in a specific workspace',
+        title: 'Set Checklist Item Due Date and Assignee',
+        description:
+          'Set or clear the due date and/or assigned member of a checklist item. Identify the item by checkItemId, or by itemText (optionally scoped with checklistName). Requires Trello Premium or higher.',
         inputSchema: {
-          workspaceId: z.string().describe('ID of the workspace to list boards from'),
+          cardId: z.string().describe('ID of the card containing the checklist item'),
+          checkItemId: z
+            .string()
+            .optional()
+            .describe('ID of the checklist item (alternative to itemText)'),
+          itemText: z
+            .string()
+            .optional()
+            .describe('Exact text of the checklist item (case-insensitive)'),
+          checklistName: z
+            .string()
+            .optional()
+            .describe('Name of the checklist to search for itemText (recommended to avoid ambiguity)'),
+          due: z
+            .string()
+            .nullable()
+            .optional()
+            .describe('Due date in ISO 8601 format, or null to clear it'),
+          memberId: z
+            .string()
+            .nullable()
+            .optional()
+            .describe('Member ID to assign to the item, or null to unassign'),
         },
       },
-      async ({ workspaceId }) => {
+      async ({ cardId, checkItemId, itemText, checklistName, due, memberId }) => {
         try {
-          const boards = await this.trelloClient.listBoardsInWorkspace(workspaceId);
+          const item = await this.trelloClient.setChecklistItemDue({
+            cardId,
+            checkItemId,
+            itemText,
+            checklistName,
+            due,
+            memberId,
+          });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(boards, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(item, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -894,34 +3619,47 @@ class TrelloServer {
       }
     );
 
-    // Get active board info
     this.server.registerTool(
-      'get_active_board_info',
+      'convert_checklist_item_to_card',
       {
-        title: 'Get Active Board Info',
-        description: 'Get information about the currently active board',
-        inputSchema: {},
+        title: 'Convert Checklist Item to Card',
+        description:
+          'Promote a checklist item to its own card in the same list. Trello removes the item from the checklist. Identify the item by checkItemId or itemText; scope with checklistId or checklistName when the text is not unique.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card containing the checklist item'),
+          checkItemId: z
+            .string()
+            .optional()
+            .describe('ID of the checklist item to convert (alternative to itemText)'),
+          itemText: z
+            .string()
+            .optional()
+            .describe('Exact text of the checklist item to convert (case-insensitive)'),
+          checklistId: z
+            .string()
+            .optional()
+            .describe('ID of the checklist containing the item'),
+          checklistName: z
+            .string()
+            .optional()
+            .describe('Name of the checklist containing the item (alternative to checklistId)'),
+        },
       },
-      async () => {
+      async ({ cardId, checkItemId, itemText, checklistId, checklistName }) => {
         try {
-          const boardId = this.trelloClient.activeBoardId;
-          if (!boardId) {
-            return {
-              content: [{ type: 'text' as const, text: 'No active board set' }],
-              isError: true,
-            };
-          }
-          const board = await this.trelloClient.getBoardById(boardId);
+          const card = await this.trelloClient.convertChecklistItemToCard({
+            cardId,
+            checkItemId,
+            itemText,
+            checklistId,
+            checklistName,
+          });
           return {
             content: [
               {
                 type: 'text' as const,
                 text: JSON.stringify(
-                  {
-                    ...board,
-                    isActive: true,
-                    activeWorkspaceId: this.trelloClient.activeWorkspaceId || 'Not set',
-                  },
+                  { id: card.id, name: card.name, idList: card.idList, url: card.url },
                   null,
                   2
                 ),
@@ -934,72 +3672,30 @@ class TrelloServer {
       }
     );
 
-    // Get card details
     this.server.registerTool(
-      'get_card',
+      'delete_checklist_item',
       {
-        title: 'Get Card',
-        description: 'Get detailed information about a specific Trello card',
+        title: 'Delete Checklist Item',
+        description: 'Delete a checklist item from a card',
         inputSchema: {
-          cardId: z.string().describe('ID of the card to fetch'),
-          includeMarkdown: z
+          cardId: z.string().describe('ID of the card containing the checklist item'),
+          checkItemId: z.string().describe('ID of the checklist item to delete'),
+          dryRun: z
             .boolean()
             .optional()
             .default(false)
-            .describe('Whether to return card description in markdown format (default: false)'),
-        },
-      },
-      async ({ cardId, includeMarkdown }) => {
-        try {
-          const card = await this.trelloClient.getCard(cardId, includeMarkdown);
-          return {
-            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
-          };
-        } catch (error) {
-          return this.handleError(error);
-        }
-      }
-    );
-
-    // Add a comment to a card
-    this.server.registerTool(
-      'add_comment',
-      {
-        title: 'Add Comment to Card',
-        description: 'Add the given text as a new comment to the given card',
-        inputSchema: {
-          cardId: z.string().describe('ID of the card to comment on'),
-          text: z.string().describe('The text of the comment to add'),
-        },
-      },
-      async ({ cardId, text }) => {
-        try {
-          const comment = await this.trelloClient.addCommentToCard(cardId, text);
-          return {
-            content: [{ type: 'text' as const, text: JSON.stringify(comment, null, 2) }],
-          };
-        } catch (error) {
-          return this.handleError(error);
-        }
-      }
-    );
-
-    // Update a comment to a card
-    this.server.registerTool(
-      'update_comment',
-      {
-        title: 'Update Comment on Card',
-        description: 'Update the given comment with the new text',
-        inputSchema: {
-          commentId: z.string().describe('ID of the comment to change'),
-          text: z.string().describe('The new text of the comment'),
+            .describe('Preview what would happen without making any changes (default: false)'),
         },
       },
-      async ({ commentId, text }) => {
+      async ({ cardId, checkItemId, dryRun }) => {
         try {
-          const success = await this.trelloClient.updateCommentOnCard(commentId, text);
+          if (dryRun) {
+            const target = await this.trelloClient.getChecklistItem(cardId, checkItemId);
+            return this.dryRunResponse(`Would delete checklist item "${target.name}"`, target);
+          }
+          const deleted = await this.trelloClient.deleteChecklistItem(cardId, checkItemId);
           return {
-            content: [{ type: 'text' as const, text: success ? 'success' : 'failure' }],
+            content: [{ type: 'text' as const, text: JSON.stringify({ deleted }, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1007,21 +3703,24 @@ class TrelloServer {
       }
     );
 
-    // Delete a comment from a card
+    // Member management tools
     this.server.registerTool(
-      'delete_comment',
+      'get_board_members',
       {
-        title: 'Delete Comment from Card',
-        description: 'Delete a comment from a Trello card',
+        title: 'Get Board Members',
+        description: 'Get all members of a specific board',
         inputSchema: {
-          commentId: z.string().describe('ID of the comment to delete'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
         },
       },
-      async ({ commentId }) => {
+      async ({ boardId }) => {
         try {
-          const success = await this.trelloClient.deleteCommentFromCard(commentId);
+          const members = await this.trelloClient.getBoardMembers(boardId);
           return {
-            content: [{ type: 'text' as const, text: success ? 'success' : 'failure' }],
+            content: [{ type: 'text' as const, text: JSON.stringify(members, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1029,26 +3728,25 @@ class TrelloServer {
       }
     );
 
-    // Get comments from a card
     this.server.registerTool(
-      'get_card_comments',
+      'find_cards_by_member',
       {
-        title: 'Get Card Comments',
-        description: 'Retrieve all comments from a specific Trello card',
+        title: 'Find Cards by Member',
+        description:
+          'List the open cards on a board assigned to a member, identified by member ID, username, or full name. Answers "what is Jane working on in this board?"',
         inputSchema: {
-          cardId: z.string().describe('ID of the card to get comments from'),
-          limit: z
-            .number()
+          boardId: z
+            .string()
             .optional()
-            .default(100)
-            .describe('Maximum number of comments to retrieve (default: 100)'),
+            .describe('ID of the Trello board (uses default if not provided)'),
+          member: z.string().describe('Member ID, username (with or without @), or full name'),
         },
       },
-      async ({ cardId, limit }) => {
+      async ({ boardId, member }) => {
         try {
-          const comments = await this.trelloClient.getCardComments(cardId, limit);
+          const result = await this.trelloClient.findCardsByMember(boardId, member);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(comments, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1056,22 +3754,21 @@ class TrelloServer {
       }
     );
 
-    // Checklist tools
     this.server.registerTool(
-      'create_checklist',
+      'assign_member_to_card',
       {
-        title: 'Create Checklist',
-        description: 'Create a new checklist',
+        title: 'Assign Member to Card',
+        description: 'Assign a member to a specific card',
         inputSchema: {
-          name: z.string().describe('Name of the checklist to create'),
-          cardId: z.string().describe('ID of the Trello card'),
+          cardId: z.string().describe('ID of the card to assign the member to'),
+          memberId: z.string().describe('ID of the member to assign to the card'),
         },
       },
-      async ({ name, cardId }) => {
+      async ({ cardId, memberId }) => {
         try {
-          const items = await this.trelloClient.createChecklist(name, cardId);
+          const card = await this.trelloClient.assignMemberToCard(cardId, memberId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(items, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1079,29 +3776,22 @@ class TrelloServer {
       }
     );
 
-    // Checklist tools
     this.server.registerTool(
-      'get_checklist_items',
+      'assign_members_to_card',
       {
-        title: 'Get Checklist Items',
-        description: 'Get all items from a checklist by name',
+        title: 'Assign Members to Card',
+        description:
+          'Assign several members to a card. Members already on the card are skipped, so a retry after a partial failure is safe. Returns "added", "skipped" or an error for each member.',
         inputSchema: {
-          name: z.string().describe('Name of the checklist to retrieve items from'),
-          cardId: z
-            .string()
-            .optional()
-            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
-          boardId: z
-            .string()
-            .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
+          cardId: z.string().describe('ID of the card to assign the members to'),
+          memberIds: z.array(z.string()).min(1).describe('IDs of the members to assign'),
         },
       },
-      async ({ name, cardId, boardId }) => {
+      async ({ cardId, memberIds }) => {
         try {
-          const items = await this.trelloClient.getChecklistItems(name, cardId, boardId);
+          const result = await this.trelloClient.assignMembersToCard(cardId, memberIds);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(items, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1110,28 +3800,20 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'add_checklist_item',
+      'remove_member_from_card',
       {
-        title: 'Add Checklist Item',
-        description: 'Add a new item to a checklist',
+        title: 'Remove Member from Card',
+        description: 'Remove a member from a specific card',
         inputSchema: {
-          text: z.string().describe('Text content of the checklist item'),
-          checkListName: z.string().describe('Name of the checklist to add the item to'),
-          cardId: z
-            .string()
-            .optional()
-            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
-          boardId: z
-            .string()
-            .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
+          cardId: z.string().describe('ID of the card to remove the member from'),
+          memberId: z.string().describe('ID of the member to remove from the card'),
         },
       },
-      async ({ text, checkListName, cardId, boardId }) => {
+      async ({ cardId, memberId }) => {
         try {
-          const item = await this.trelloClient.addChecklistItem(text, checkListName, cardId, boardId);
+          const card = await this.trelloClient.removeMemberFromCard(cardId, memberId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(item, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1140,31 +3822,39 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'find_checklist_items_by_description',
+      'remove_all_members_from_card',
       {
-        title: 'Find Checklist Items by Description',
-        description: 'Search for checklist items containing specific text in their description',
+        title: 'Remove All Members from Card',
+        description:
+          'Unassign every member from a card. Returns how many were removed; a card with no members is left unchanged.',
         inputSchema: {
-          description: z.string().describe('Text to search for in checklist item descriptions'),
-          cardId: z
-            .string()
-            .optional()
-            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
           boardId: z
             .string()
             .optional()
             .describe('ID of the Trello board (uses default if not provided)'),
+          cardId: z.string().describe('ID of the card to clear members from'),
+          dryRun: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Preview what would happen without making any changes (default: false)'),
         },
       },
-      async ({ description, cardId, boardId }) => {
+      async ({ boardId, cardId, dryRun }) => {
         try {
-          const items = await this.trelloClient.findChecklistItemsByDescription(
-            description,
-            cardId,
-            boardId
-          );
+          if (dryRun) {
+            const { members, failures } = await this.trelloClient.getCardMemberDetails(cardId);
+            const count = members.length + failures.length;
+            return this.dryRunResponse(
+              count === 0
+                ? 'Card has no members; nothing would change'
+                : `Would remove ${count} member(s) from the card`,
+              { cardId, members, failures }
+            );
+          }
+          const result = await this.trelloClient.removeAllMembersFromCard(boardId, cardId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(items, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1173,26 +3863,21 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'get_acceptance_criteria',
+      'set_card_members',
       {
-        title: 'Get Acceptance Criteria',
-        description: 'Get all items from the "Acceptance Criteria" checklist',
+        title: 'Set Card Members',
+        description:
+          'Set the exact list of members on a card. Members not in memberIds are removed and missing ones are added; returns the net changes. Pass an empty array to clear all members.',
         inputSchema: {
-          cardId: z
-            .string()
-            .optional()
-            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
-          boardId: z
-            .string()
-            .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
+          cardId: z.string().describe('ID of the card'),
+          memberIds: z.array(z.string()).describe('IDs of every member the card should have'),
         },
       },
-      async ({ cardId, boardId }) => {
+      async ({ cardId, memberIds }) => {
         try {
-          const items = await this.trelloClient.getAcceptanceCriteria(cardId, boardId);
+          const changes = await this.trelloClient.setCardMembers(cardId, memberIds);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(items, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(changes, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1200,34 +3885,29 @@ class TrelloServer {
       }
     );
 
+    // Label management tools
     this.server.registerTool(
-      'get_checklist_by_name',
+      'get_board_labels',
       {
-        title: 'Get Checklist by Name',
-        description: 'Get a complete checklist with all its items and completion percentage',
+        title: 'Get Board Labels',
+        description: 'Get all labels of a specific board',
         inputSchema: {
-          name: z.string().describe('Name of the checklist to retrieve'),
-          cardId: z
-            .string()
-            .optional()
-            .describe('ID of the card to scope checklist search to (recommended to avoid ambiguity)'),
           boardId: z
             .string()
             .optional()
             .describe('ID of the Trello board (uses default if not provided)'),
+          noCache: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Skip the ETag cache and always fetch a fresh copy (default: false)'),
         },
       },
-      async ({ name, cardId, boardId }) => {
+      async ({ boardId, noCache }) => {
         try {
-          const checklist = await this.trelloClient.getChecklistByName(name, cardId, boardId);
-          if (!checklist) {
-            return {
-              content: [{ type: 'text' as const, text: `Checklist "${name}" not found` }],
-              isError: true,
-            };
-          }
+          const labels = await this.trelloClient.getBoardLabels(boardId, { noCache });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(checklist, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(labels, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1236,51 +3916,28 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'update_checklist_item',
+      'get_board_labels_usage',
       {
-        title: 'Update Checklist Item',
-        description: 'Update a checklist item name, state, position, due date, reminder, or assigned member',
+        title: 'Get Board Label Usage',
+        description:
+          'Count how many cards use each label on a board, most used first, and flag labels no card uses. Useful for pruning redundant labels.',
         inputSchema: {
-          cardId: z.string().describe('ID of the card containing the checklist item'),
-          checkItemId: z.string().describe('ID of the checklist item to update'),
-          state: z
-            .enum(['complete', 'incomplete'])
-            .optional()
-            .describe('New state for the checklist item'),
-          name: z.string().optional().describe('New text for the checklist item'),
-          pos: z
-            .union([z.number(), z.enum(['top', 'bottom'])])
-            .optional()
-            .describe('New position for the checklist item'),
-          due: z
+          boardId: z
             .string()
-            .nullable()
-            .optional()
-            .describe('New due date for the checklist item in ISO 8601 format, or null to clear it'),
-          dueReminder: z
-            .number()
-            .nullable()
             .optional()
-            .describe('Reminder offset in minutes before due date, or null to clear it'),
-          idMember: z
-            .string()
-            .nullable()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          includeClosed: z
+            .boolean()
             .optional()
-            .describe('Member ID to assign to the checklist item, or null to clear it'),
+            .default(false)
+            .describe('Also count archived cards (default: false, open cards only)'),
         },
       },
-      async ({ cardId, checkItemId, name, state, pos, due, dueReminder, idMember }) => {
+      async ({ boardId, includeClosed }) => {
         try {
-          const item = await this.trelloClient.updateChecklistItem(cardId, checkItemId, {
-            name,
-            state,
-            pos,
-            due,
-            dueReminder,
-            idMember,
-          });
+          const usage = await this.trelloClient.getBoardLabelsUsage(boardId, includeClosed);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(item, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(usage, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1289,20 +3946,23 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'delete_checklist_item',
+      'get_card_count_by_label',
       {
-        title: 'Delete Checklist Item',
-        description: 'Delete a checklist item from a card',
+        title: 'Get Card Count by Label',
+        description:
+          'Count open cards per list and label on a board, e.g. how many "bug" cards sit in each column. Returns lists in board order, each with per-label counts, plus board-wide totals per label.',
         inputSchema: {
-          cardId: z.string().describe('ID of the card containing the checklist item'),
-          checkItemId: z.string().describe('ID of the checklist item to delete'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
         },
       },
-      async ({ cardId, checkItemId }) => {
+      async ({ boardId }) => {
         try {
-          const deleted = await this.trelloClient.deleteChecklistItem(cardId, checkItemId);
+          const report = await this.trelloClient.getCardCountByLabel(boardId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify({ deleted }, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(report, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1310,24 +3970,30 @@ class TrelloServer {
       }
     );
 
-    // Member management tools
     this.server.registerTool(
-      'get_board_members',
+      'create_label',
       {
-        title: 'Get Board Members',
-        description: 'Get all members of a specific board',
+        title: 'Create Label',
+        description: 'Create a new label on a board',
         inputSchema: {
           boardId: z
             .string()
             .optional()
             .describe('ID of the Trello board (uses default if not provided)'),
+          name: z.string().describe('Name of the label'),
+          color: z
+            .string()
+            .optional()
+            .describe(
+              'Color of the label (e.g., "red", "blue", "green", "yellow", "orange", "purple", "pink", "sky", "lime", "black", "null")'
+            ),
         },
       },
-      async ({ boardId }) => {
+      async ({ boardId, name, color }) => {
         try {
-          const members = await this.trelloClient.getBoardMembers(boardId);
+          const label = await this.trelloClient.createLabel(boardId, name, color);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(members, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(label, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1336,20 +4002,21 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'assign_member_to_card',
+      'update_label',
       {
-        title: 'Assign Member to Card',
-        description: 'Assign a member to a specific card',
+        title: 'Update Label',
+        description: 'Update an existing label',
         inputSchema: {
-          cardId: z.string().describe('ID of the card to assign the member to'),
-          memberId: z.string().describe('ID of the member to assign to the card'),
+          labelId: z.string().describe('ID of the label to update'),
+          name: z.string().optional().describe('New name for the label'),
+          color: z.string().optional().describe('New color for the label'),
         },
       },
-      async ({ cardId, memberId }) => {
+      async ({ labelId, name, color }) => {
         try {
-          const card = await this.trelloClient.assignMemberToCard(cardId, memberId);
+          const label = await this.trelloClient.updateLabel(labelId, name, color);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(label, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1358,20 +4025,29 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'remove_member_from_card',
+      'set_label_color',
       {
-        title: 'Remove Member from Card',
-        description: 'Remove a member from a specific card',
+        title: 'Set Label Color',
+        description:
+          "Change only a label's color, keeping its name. The label can be given by ID or by its current name. Use update_label to rename.",
         inputSchema: {
-          cardId: z.string().describe('ID of the card to remove the member from'),
-          memberId: z.string().describe('ID of the member to remove from the card'),
+          label: z.string().min(1).describe('Label ID, or the current label name'),
+          color: z
+            .string()
+            .describe(
+              'New color: green, yellow, orange, red, purple, blue, sky, lime, pink, or black, optionally with a _dark or _light suffix'
+            ),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the board used to resolve a label name (uses default if not provided)'),
         },
       },
-      async ({ cardId, memberId }) => {
+      async ({ label, color, boardId }) => {
         try {
-          const card = await this.trelloClient.removeMemberFromCard(cardId, memberId);
+          const updated = await this.trelloClient.setLabelColor({ label, color, boardId });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(updated, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1379,24 +4055,32 @@ class TrelloServer {
       }
     );
 
-    // Label management tools
     this.server.registerTool(
-      'get_board_labels',
+      'delete_label',
       {
-        title: 'Get Board Labels',
-        description: 'Get all labels of a specific board',
+        title: 'Delete Label',
+        description: 'Delete a label from a board',
         inputSchema: {
-          boardId: z
-            .string()
+          labelId: z.string().describe('ID of the label to delete'),
+          dryRun: z
+            .boolean()
             .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
+            .default(false)
+            .describe('Preview what would happen without making any changes (default: false)'),
         },
       },
-      async ({ boardId }) => {
+      async ({ labelId, dryRun }) => {
         try {
-          const labels = await this.trelloClient.getBoardLabels(boardId);
+          if (dryRun) {
+            const target = await this.trelloClient.getLabel(labelId);
+            return this.dryRunResponse(
+              `Would delete label "${target.name || '(no name)'}" (${target.color ?? 'no color'}) from board ${target.idBoard} and remove it from every card`,
+              target
+            );
+          }
+          await this.trelloClient.deleteLabel(labelId);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(labels, null, 2) }],
+            content: [{ type: 'text' as const, text: 'Label deleted successfully' }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1405,29 +4089,36 @@ class TrelloServer {
     );
 
     this.server.registerTool(
-      'create_label',
+      'add_label_to_cards',
       {
-        title: 'Create Label',
-        description: 'Create a new label on a board',
+        title: 'Add Label to Cards',
+        description:
+          'Apply one label to many cards at once, identified by labelId or by color (resolved to the board label). Cards that already have the label are skipped, so a retry after a partial failure is safe. Returns "added", "skipped" or an error for each card.',
         inputSchema: {
-          boardId: z
+          cardIds: z.array(z.string()).min(1).describe('IDs of the cards to label'),
+          labelId: z.string().optional().describe('ID of the label to apply'),
+          color: z
             .string()
             .optional()
-            .describe('ID of the Trello board (uses default if not provided)'),
-          name: z.string().describe('Name of the label'),
-          color: z
+            .describe('Color of the board label to apply (alternative to labelId)'),
+          boardId: z
             .string()
             .optional()
-            .describe(
-              'Color of the label (e.g., "red", "blue", "green", "yellow", "orange", "purple", "pink", "sky", "lime", "black", "null")'
-            ),
+            .describe('ID of the board used to resolve color (uses default if not provided)'),
+          concurrency: bulkConcurrencySchema,
         },
       },
-      async ({ boardId, name, color }) => {
+      async ({ cardIds, labelId, color, boardId, concurrency }) => {
         try {
-          const label = await this.trelloClient.createLabel(boardId, name, color);
+          const result = await this.trelloClient.addLabelToCards({
+            cardIds,
+            labelId,
+            color,
+            boardId,
+            concurrency,
+          });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(label, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1435,22 +4126,27 @@ class TrelloServer {
       }
     );
 
+    // Set a card's exact labels
     this.server.registerTool(
-      'update_label',
+      'set_card_labels',
       {
-        title: 'Update Label',
-        description: 'Update an existing label',
+        title: 'Set Card Labels',
+        description:
+          'Set the exact labels on a card. Labels not listed are removed and missing ones are added; returns the net changes. Pass an empty array to clear all labels.',
         inputSchema: {
-          labelId: z.string().describe('ID of the label to update'),
-          name: z.string().optional().describe('New name for the label'),
-          color: z.string().optional().describe('New color for the label'),
+          cardId: z.string().describe('ID of the card'),
+          labels: z
+            .array(z.string())
+            .describe(
+              "Label IDs or color names of every label the card should have; a color must match a single label on the card's board"
+            ),
         },
       },
-      async ({ labelId, name, color }) => {
+      async ({ cardId, labels }) => {
         try {
-          const label = await this.trelloClient.updateLabel(labelId, name, color);
+          const changes = await this.trelloClient.setCardLabels(cardId, labels);
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(label, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(changes, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1458,20 +4154,56 @@ class TrelloServer {
       }
     );
 
+    // Strip a label from every card on a board
     this.server.registerTool(
-      'delete_label',
+      'remove_label_from_all_cards',
       {
-        title: 'Delete Label',
-        description: 'Delete a label from a board',
+        title: 'Remove Label from All Cards',
+        description:
+          'Take a label (by labelId or color) off every card on a board, archived cards included, while keeping the label itself. Use it to retire a label or before deleting it. Returns how many cards were affected.',
         inputSchema: {
-          labelId: z.string().describe('ID of the label to delete'),
+          labelId: z.string().optional().describe('ID of the label to remove'),
+          color: z
+            .string()
+            .optional()
+            .describe('Color of the board label to remove (alternative to labelId)'),
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          dryRun: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Only list the cards that carry the label (default: false)'),
+          concurrency: bulkConcurrencySchema,
         },
       },
-      async ({ labelId }) => {
+      async ({ labelId, color, boardId, dryRun, concurrency }) => {
         try {
-          await this.trelloClient.deleteLabel(labelId);
+          const found = await this.trelloClient.findCardsWithLabel({ labelId, color, boardId });
+          if (dryRun) {
+            return this.dryRunResponse(
+              `Would remove label ${found.labelId} from ${found.cards.length} card(s)`,
+              found.cards
+            );
+          }
+          const result = await this.trelloClient.removeLabelFromCards(
+            found.labelId,
+            found.cards.map(card => card.id),
+            concurrency
+          );
           return {
-            content: [{ type: 'text' as const, text: 'Label deleted successfully' }],
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  { labelId: found.labelId, affected: result.removed.length, ...result },
+                  null,
+                  2
+                ),
+              },
+            ],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1522,7 +4254,46 @@ class TrelloServer {
             pos,
           });
           return {
-            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+            content: [{ type: 'text' as const, text: JSON.stringify(card, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Copy a card into several lists
+    this.server.registerTool(
+      'duplicate_card_to_multiple_lists',
+      {
+        title: 'Duplicate Card to Multiple Lists',
+        description:
+          'Copy one card into several lists at once (lists may be on different boards), e.g. to seed the same task across project boards. Failures for individual lists are reported without stopping the others.',
+        inputSchema: {
+          sourceCardId: z.string().describe('ID of the source card to copy'),
+          targetListIds: z
+            .array(z.string())
+            .min(1)
+            .describe('IDs of the lists to copy the card into'),
+          keepFromSource: z
+            .string()
+            .optional()
+            .describe(
+              'Comma-separated list of properties to copy: "all" (default), or any combination of: attachments, checklists, comments, customFields, due, start, labels, members, stickers'
+            ),
+          concurrency: bulkConcurrencySchema,
+        },
+      },
+      async ({ sourceCardId, targetListIds, keepFromSource, concurrency }) => {
+        try {
+          const result = await this.trelloClient.duplicateCardToLists({
+            sourceCardId,
+            listIds: targetListIds,
+            keepFromSource,
+            concurrency,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
           };
         } catch (error) {
           return this.handleError(error);
@@ -1571,13 +4342,55 @@ class TrelloServer {
       }
     );
 
+    // Copy a checklist by ID or name onto another card
+    this.server.registerTool(
+      'copy_checklist_to_card',
+      {
+        title: 'Copy Checklist to Card',
+        description:
+          'Copy a checklist from one card to another, choosing it by ID or by name on the source card, e.g. to reuse a standard Definition of Done. Optionally rename it and reset every item to incomplete. Returns the new checklist ID, and the IDs of any items that could not be reset.',
+        inputSchema: {
+          sourceCardId: z.string().describe('ID of the card that has the checklist'),
+          sourceChecklist: z
+            .string()
+            .describe('ID or name of the checklist on the source card'),
+          targetCardId: z.string().describe('ID of the card to copy the checklist to'),
+          name: z
+            .string()
+            .optional()
+            .describe('Name for the copy (defaults to the source checklist name)'),
+          resetStates: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Mark every copied item incomplete (default: false, keep source states)'),
+        },
+      },
+      async ({ sourceCardId, sourceChecklist, targetCardId, name, resetStates }) => {
+        try {
+          const result = await this.trelloClient.copyChecklistToCard({
+            sourceCardId,
+            sourceChecklist,
+            targetCardId,
+            name,
+            resetStates,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(result, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
     // Add multiple cards to a list
     this.server.registerTool(
       'add_cards_to_list',
       {
         title: 'Add Cards to List',
         description:
-          'Add multiple cards to a list in one operation. Cards are created sequentially (Trello API does not support batch writes). Rate limiting is handled automatically.',
+          'Add multiple cards to a list in one operation. Cards are created in parallel (a few at a time, since the Trello API does not support batch writes); failures are reported per card. Rate limiting is handled automatically.',
         inputSchema: {
           listId: z.string().describe('ID of the list to add cards to'),
           cards: z
@@ -1600,11 +4413,12 @@ class TrelloServer {
               })
             )
             .describe('Array of cards to create (max 50)'),
+          concurrency: bulkConcurrencySchema,
         },
       },
-      async ({ listId, cards }) => {
+      async ({ listId, cards, concurrency }) => {
         try {
-          const results = await this.trelloClient.batchAddCards(listId, cards);
+          const results = await this.trelloClient.batchAddCards(listId, cards, concurrency);
           return {
             content: [
               {
@@ -1619,6 +4433,179 @@ class TrelloServer {
       }
     );
 
+    // Create cards across lists
+    this.server.registerTool(
+      'create_cards_bulk',
+      {
+        title: 'Create Cards in Bulk',
+        description:
+          'Create many cards, possibly in different lists, e.g. to import a task list or break an epic into cards. Cards are created in parallel (a few at a time); failures are reported per card and do not stop the rest.',
+        inputSchema: {
+          cards: z
+            .array(
+              z.object({
+                listId: z.string().describe('ID of the list to create the card in'),
+                name: z.string().describe('Name of the card'),
+                desc: z.string().optional().describe('Description of the card'),
+                due: z.string().optional().describe('Due date for the card (ISO 8601 format)'),
+                labels: z
+                  .array(z.string())
+                  .optional()
+                  .describe('Array of label IDs to apply to the card'),
+                members: z
+                  .array(z.string())
+                  .optional()
+                  .describe('Array of member IDs to assign to the card'),
+              })
+            )
+            .min(1)
+            .describe('Cards to create (max 50)'),
+          concurrency: bulkConcurrencySchema,
+        },
+      },
+      async ({ cards, concurrency }) => {
+        try {
+          const results = await this.trelloClient.createCardsBulk(cards, concurrency);
+          const summary = {
+            created: results.filter(result => result.success).length,
+            failed: results.filter(result => !result.success).length,
+            // Report IDs only; the full card objects are for batchAddCards
+            results: results.map(({ index, name, success, id, error }) => ({
+              index,
+              name,
+              success,
+              id,
+              error,
+            })),
+          };
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(summary, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Board export and import
+    this.server.registerTool(
+      'export_board_json',
+      {
+        title: 'Export Board JSON',
+        description:
+          'Export a board (lists, cards, checklists, labels, members, optionally comments) as one normalized JSON document for backup or migration. Output is deterministic for diffing. Large exports are written to the export directory and the file path is returned instead.',
+        inputSchema: {
+          boardId: z
+            .string()
+            .optional()
+            .describe('ID of the Trello board (uses default if not provided)'),
+          includeClosed: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Include archived lists and cards (default: false)'),
+          includeComments: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Include card comments (default: false)'),
+        },
+      },
+      async ({ boardId, includeClosed, includeComments }) => {
+        try {
+          const doc = await this.trelloClient.exportBoard(boardId, {
+            includeClosed,
+            includeComments,
+          });
+          const json = JSON.stringify(doc, null, 2);
+          if (Buffer.byteLength(json) <= TrelloClient.EXPORT_INLINE_LIMIT_BYTES) {
+            return { content: [{ type: 'text' as const, text: json }] };
+          }
+          const filePath = await this.trelloClient.saveBoardExport(doc, json);
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    path: filePath,
+                    bytes: Buffer.byteLength(json),
+                    lists: doc.lists.length,
+                    cards: doc.cards.length,
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'import_board_json',
+      {
+        title: 'Import Board JSON',
+        description:
+          'Recreate labels, lists, cards, checklists, and link attachments from an export_board_json document, on a new board or appended to an existing one. Uploaded attachments, comments, and member assignments are reported as skipped.',
+        inputSchema: {
+          document: z
+            .string()
+            .optional()
+            .describe('Export document as a JSON string (provide this or path)'),
+          path: z
+            .string()
+            .optional()
+            .describe(
+              'Path to an export file written by export_board_json, inside TRELLO_EXPORT_DIR (provide this or document)'
+            ),
+          targetBoardId: z
+            .string()
+            .optional()
+            .describe('Existing board to import into; a new board is created when omitted'),
+          name: z
+            .string()
+            .optional()
+            .describe("Name for the new board (defaults to the exported board's name)"),
+          idOrganization: z
+            .string()
+            .optional()
+            .describe('Workspace for the new board (defaults to the active workspace)'),
+        },
+      },
+      async ({ document, path, targetBoardId, name, idOrganization }) => {
+        try {
+          if ((document === undefined) === (path === undefined)) {
+            throw new McpError(ErrorCode.InvalidParams, 'Provide exactly one of document or path');
+          }
+          let doc;
+          if (path !== undefined) {
+            doc = await this.trelloClient.readBoardExport(path);
+          } else {
+            try {
+              doc = parseBoardExport(JSON.parse(document!));
+            } catch (error) {
+              if (error instanceof McpError) throw error;
+              throw new McpError(ErrorCode.InvalidParams, 'document is not valid JSON');
+            }
+          }
+          const summary = await this.trelloClient.importBoard(doc, {
+            targetBoardId,
+            name,
+            idOrganization,
+          });
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(summary, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
     // Custom field management tools
     this.server.registerTool(
       'get_board_custom_fields',
@@ -1668,6 +4655,28 @@ class TrelloServer {
       }
     );
 
+    this.server.registerTool(
+      'get_card_custom_field_values',
+      {
+        title: 'Get Card Custom Field Values',
+        description:
+          'Get the custom field values set on a card as { name, type, value }. Dropdown values are returned as option text, dates as ISO strings, numbers and checkboxes as JSON numbers and booleans. Requires Trello Standard plan or higher.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+        },
+      },
+      async ({ cardId }) => {
+        try {
+          const values = await this.trelloClient.getCardCustomFieldValues(cardId);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(values, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
     this.server.registerTool(
       'update_card_custom_field',
       {
@@ -1726,7 +4735,8 @@ class TrelloServer {
       'get_card_history',
       {
         title: 'Get Card History',
-        description: 'Get the history/actions of a specific card',
+        description:
+          'Get the history/actions of a specific card, newest first. Returns { items, nextCursor }; when limit is set, pass nextCursor back as cursor to fetch older actions.',
         inputSchema: {
           cardId: z.string().describe('ID of the card to get history for'),
           filter: z
@@ -1739,11 +4749,47 @@ class TrelloServer {
             .number()
             .optional()
             .describe('Optional: Number of actions to fetch (default: all)'),
+          cursor: z
+            .string()
+            .optional()
+            .describe('nextCursor from a previous response, to fetch the following page'),
+        },
+      },
+      async ({ cardId, filter, limit, cursor }) => {
+        try {
+          const page = await fetchPage(limit, cursor, before =>
+            this.trelloClient.getCardHistory(cardId, filter, limit, before)
+          );
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(page, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    this.server.registerTool(
+      'list_history',
+      {
+        title: 'Card List History',
+        description:
+          'Show where a card has lived: each list it entered, when, who moved it there, and how long it stayed, plus total hours per list. Answers "how long did this sit in Review?"',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+          limit: z
+            .number()
+            .int()
+            .min(1)
+            .max(1000)
+            .optional()
+            .default(100)
+            .describe('Maximum creation and move actions to read (default: 100, max: 1000)'),
         },
       },
-      async ({ cardId, filter, limit }) => {
+      async ({ cardId, limit }) => {
         try {
-          const history = await this.trelloClient.getCardHistory(cardId, filter, limit);
+          const history = await this.trelloClient.getCardListHistory(cardId, limit);
           return {
             content: [{ type: 'text' as const, text: JSON.stringify(history, null, 2) }],
           };
@@ -1753,6 +4799,102 @@ class TrelloServer {
       }
     );
 
+    // Attachment content with size cap
+    this.server.registerTool(
+      'get_attachment_content',
+      {
+        title: 'Get Attachment Content',
+        description:
+          'Fetch the content of an uploaded card attachment (e.g. a screenshot to read). Images are returned as image content; other types require allowAnyType and are returned as base64. Downloads over the size cap (default 5MB, TRELLO_MAX_ATTACHMENT_BYTES) are rejected.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card containing the attachment'),
+          attachmentId: z.string().describe('ID of the attachment'),
+          allowAnyType: z
+            .boolean()
+            .optional()
+            .default(false)
+            .describe('Allow non-image attachments (default: false)'),
+          maxBytes: z
+            .number()
+            .int()
+            .positive()
+            .optional()
+            .describe('Override the size cap in bytes for this call'),
+        },
+      },
+      async ({ cardId, attachmentId, allowAnyType, maxBytes }) => {
+        try {
+          const result = await this.trelloClient.getAttachmentContent(cardId, attachmentId, {
+            allowAnyType,
+            maxBytes,
+          });
+          const summary = `${result.fileName} (${result.mimeType}, ${result.bytes} bytes)`;
+          if (result.mimeType.startsWith('image/')) {
+            return {
+              content: [
+                { type: 'image' as const, data: result.data, mimeType: result.mimeType },
+                { type: 'text' as const, text: summary },
+              ],
+            };
+          }
+          return {
+            content: [
+              {
+                type: 'text' as const,
+                text: JSON.stringify(
+                  {
+                    fileName: result.fileName,
+                    mimeType: result.mimeType,
+                    bytes: result.bytes,
+                    data: result.data,
+                  },
+                  null,
+                  2
+                ),
+              },
+            ],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
+    // Compact card history
+    this.server.registerTool(
+      'get_card_actions_summary',
+      {
+        title: 'Get Card Actions Summary',
+        description:
+          'Get a card history as a compact timeline of one-line entries (e.g. "2024-05-01 Jane moved from Doing to Done"), newest first. Covers moves, labels, members, due dates, and comments by default. Use get_card_history for raw action data.',
+        inputSchema: {
+          cardId: z.string().describe('ID of the card'),
+          limit: z
+            .number()
+            .int()
+            .positive()
+            .optional()
+            .describe('Maximum number of actions to summarize'),
+          types: z
+            .array(z.string())
+            .optional()
+            .describe(
+              'Action types to include (e.g., ["updateCard", "commentCard"]). Defaults to moves, labels, members, due dates, and comments.'
+            ),
+        },
+      },
+      async ({ cardId, limit, types }) => {
+        try {
+          const timeline = await this.trelloClient.getCardActionsSummary(cardId, limit, types);
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(timeline, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
     // Download attachment tool
     this.server.registerTool(
       'download_attachment',
@@ -1804,6 +4946,27 @@ class TrelloServer {
   }
 
   private setupHealthEndpoints() {
+    // Client request/retry counters
+    this.server.registerTool(
+      'get_client_stats',
+      {
+        title: 'Get Client Stats',
+        description:
+          'Report Trello client counters since the server started: requests, retries (rate limits and timeouts), exhausted retries, timeouts, failures, and ETag cache hits, plus the active retry/backoff and timeout settings.',
+        inputSchema: {},
+      },
+      async () => {
+        try {
+          const stats = this.trelloClient.getStats();
+          return {
+            content: [{ type: 'text' as const, text: JSON.stringify(stats, null, 2) }],
+          };
+        } catch (error) {
+          return this.handleError(error);
+        }
+      }
+    );
+
     // Basic health check endpoint
     this.server.registerTool('get_health', HealthEndpointSchemas.basicHealth, async () => {
       try {
@@ -1813,6 +4976,15 @@ class TrelloServer {
       }
     });
 
+    // Trello API reachability check
+    this.server.registerTool('trello_health', HealthEndpointSchemas.apiHealth, async () => {
+      try {
+        return await this.healthEndpoints.getApiHealth();
+      } catch (error) {
+        return this.handleError(error);
+      }
+    });
+
     // Detailed health diagnostic endpoint
     this.server.registerTool(
       'get_health_detailed',
@@ -1865,8 +5037,9 @@ class TrelloServer {
   async run() {
     const transport = new StdioServerTransport();
     // Load configuration before starting the server
-    await this.trelloClient.loadConfig().catch(() => {
-      // Continue with default config if loading fails
+    await this.trelloClient.loadConfig().catch(error => {
+      // Continue with whatever loaded, but say why the rest was ignored
+      console.error('Failed to load saved configuration:', error);
     });
     await this.server.connect(transport);
   }
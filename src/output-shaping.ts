@@ -0,0 +1,108 @@
+import type { McpServer } from '@modelcontextprotocol/sdk/server/mcp.js';
+
+/** How much read tools return: "full" is everything Trello sent, "compact" trims it */
+export const OUTPUT_VERBOSITIES = ['compact', 'full'] as const;
+export type OutputVerbosity = (typeof OUTPUT_VERBOSITIES)[number];
+
+/**
+ * Trello fields that describe rendering, limits or bookkeeping rather than the
+ * object itself. Compact output drops them wherever they appear.
+ */
+const NON_ESSENTIAL_FIELDS: ReadonlySet<string> = new Set([
+  'badges',
+  'checkItemStates',
+  'cover',
+  'creationMethod',
+  'descData',
+  'idAttachmentCover',
+  'idMembersVoted',
+  'labelNames',
+  'limits',
+  'manualCoverAttachment',
+  'nodeId',
+  'prefs',
+]);
+
+/** Tools that only read, by naming convention; only their output is shaped */
+export function isReadTool(name: string): boolean {
+  return /^(get|list|find|search)_/.test(name) || name === 'whoami';
+}
+
+/**
+ * Drop non-essential fields, nulls and empty arrays from object properties,
+ * recursively. Array elements are kept as they are so positions stay meaningful.
+ */
+export function compactValue(value: unknown): unknown {
+  if (Array.isArray(value)) {
+    return value.map(compactValue);
+  }
+  if (typeof value !== 'object' || value === null) {
+    return value;
+  }
+  const result: Record<string, unknown> = {};
+  for (const [key, entry] of Object.entries(value)) {
+    if (NON_ESSENTIAL_FIELDS.has(key) || entry === null || entry === undefined) {
+      continue;
+    }
+    if (Array.isArray(entry) && entry.length === 0) {
+      continue;
+    }
+    result[key] = compactValue(entry);
+  }
+  return result;
+}
+
+type TextToolResult = {
+  content?: Array<{ type: string; text?: string }>;
+  isError?: boolean;
+};
+
+/**
+ * Re-render the JSON text of a tool result per verbosity. Errors and text that
+ * is not JSON are returned untouched.
+ */
+export function shapeToolResult<T>(result: T, verbosity: OutputVerbosity): T {
+  const shaped = result as TextToolResult;
+  if (verbosity === 'full' || shaped?.isError || !Array.isArray(shaped?.content)) {
+    return result;
+  }
+  return {
+    ...shaped,
+    content: shaped.content.map(item => {
+      if (item.type !== 'text' || typeof item.text !== 'string') {
+        return item;
+      }
+      try {
+        return { ...item, text: JSON.stringify(compactValue(JSON.parse(item.text))) };
+      } catch {
+        return item;
+      }
+    }),
+  } as T;
+}
+
+type ToolRegistrar = (
+  name: string,
+  config: unknown,
+  handler: (...args: unknown[]) => unknown
+) => unknown;
+
+/**
+ * Route every read tool's result through shapeToolResult using the verbosity in
+ * effect when the tool runs. Must be installed before tools are registered.
+ */
+export function installResponseShaping(
+  server: McpServer,
+  getVerbosity: () => OutputVerbosity
+): void {
+  const target = server as unknown as { registerTool: ToolRegistrar };
+  const original = target.registerTool.bind(server);
+  target.registerTool = (name, config, handler) => {
+    if (!isReadTool(name)) {
+      return original(name, config, handler);
+    }
+    return original(name, config, async (...args: unknown[]) =>
+      shapeToolResult(await handler(...args), getVerbosity())
+    );
+  };
+}
@@ -0,0 +1,48 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+/**
+ * Opaque paging position. Trello action endpoints return newest first and accept
+ * `before=<actionId>`, so the next page starts just before the last item seen.
+ */
+interface CursorState {
+  before: string;
+}
+
+export interface Page<T> {
+  items: T[];
+  nextCursor: string | null;
+}
+
+export function encodeCursor(state: CursorState): string {
+  return Buffer.from(JSON.stringify(state), 'utf8').toString('base64url');
+}
+
+export function decodeCursor(cursor: string): CursorState {
+  try {
+    const state = JSON.parse(Buffer.from(cursor, 'base64url').toString('utf8'));
+    if (typeof state?.before === 'string' && state.before.length > 0) {
+      return { before: state.before };
+    }
+  } catch {
+    // Fall through to the error below
+  }
+  throw new McpError(ErrorCode.InvalidParams, `Invalid cursor: ${cursor}`);
+}
+
+/**
+ * Fetch one page of id-ordered items. A full page yields a cursor for the next one;
+ * a short page means the end was reached. Without a limit there is nothing to page.
+ */
+export async function fetchPage<T extends { id: string }>(
+  limit: number | undefined,
+  cursor: string | undefined,
+  fetch: (before: string | undefined) => Promise<T[]>
+): Promise<Page<T>> {
+  const before = cursor ? decodeCursor(cursor).before : undefined;
+  const items = await fetch(before);
+  const nextCursor =
+    limit !== undefined && items.length >= limit && items.length > 0
+      ? encodeCursor({ before: items[items.length - 1].id })
+      : null;
+  return { items, nextCursor };
+}
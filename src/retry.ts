@@ -0,0 +1,31 @@
+export interface RetryOptions {
+  maxRetries: number;
+  baseDelayMs: number;
+  maxDelayMs: number;
+}
+
+export const DEFAULT_RETRY_OPTIONS: RetryOptions = {
+  maxRetries: 3,
+  baseDelayMs: 1000,
+  maxDelayMs: 30000,
+};
+
+/** How long a single Trello request may take before it is aborted */
+export const DEFAULT_TIMEOUT_MS = 15000;
+
+/**
+ * Delay before retry number `attempt` (0-based), using exponential backoff with
+ * "equal jitter": the exponential step is capped at maxDelayMs, then the wait is
+ * drawn uniformly from the upper half of that window. Keeping a floor of half the
+ * step preserves backoff, while the random half spreads out clients that were
+ * throttled together (e.g. during bulk operations) so they don't retry in lockstep.
+ */
+export function computeBackoffDelay(
+  attempt: number,
+  options: Pick<RetryOptions, 'baseDelayMs' | 'maxDelayMs'>,
+  random: () => number = Math.random
+): number {
+  const step = Math.min(options.maxDelayMs, options.baseDelayMs * Math.pow(2, attempt));
+  const half = step / 2;
+  return Math.round(half + random() * half);
+}
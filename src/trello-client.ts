@@ -1,12 +1,15 @@
-import axios, { AxiosInstance, CreateAxiosDefaults } from 'axios';
+import axios, { AxiosInstance, AxiosRequestConfig, CreateAxiosDefaults } from 'axios';
 import { HttpsProxyAgent } from 'https-proxy-agent';
 import {
   TrelloConfig,
+  TrelloClientStats,
   TrelloCard,
   TrelloList,
   TrelloAction,
   TrelloAttachment,
   TrelloBoard,
+  TrelloBoardPrefs,
+  TrelloBoardPlugin,
   TrelloWorkspace,
   EnhancedTrelloCard,
   TrelloChecklist,
@@ -14,32 +17,128 @@ import {
   TrelloCheckItemUpdate,
   CheckList,
   CheckListItem,
+  CheckListItemMatch,
   TrelloComment,
   TrelloMember,
+  TrelloAuthenticatedMember,
+  TrelloReactionSummary,
+  TrelloNotification,
   TrelloLabelDetails,
+  CardLabelResult,
   TrelloCustomFieldDefinition,
   TrelloCustomFieldOption,
   TrelloCustomFieldItem,
 } from './types.js';
 import { createTrelloRateLimiters } from './rate-limiter.js';
+import {
+  computeBackoffDelay,
+  DEFAULT_RETRY_OPTIONS,
+  DEFAULT_TIMEOUT_MS,
+  RetryOptions,
+} from './retry.js';
+import { isIdempotentRequest, isTimeoutError, TrelloTimeoutError } from './errors.js';
 import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
 import * as fs from 'fs/promises';
 import * as path from 'path';
 import * as attachments from './trello/attachments.js';
+import { NotificationSummary, summarizeNotification } from './trello/notifications.js';
+import { buildCheckItemMatcher, getCardChecklists, resolveCheckItem } from './trello/checklists.js';
+import { formatLabel } from './trello/labels.js';
+import { assertWellFormedIds, isTrelloId } from './trello/ids.js';
+import { findWipLimit, parseWipLimits } from './trello/wip-limits.js';
+import { parseDefaultLists } from './trello/default-lists.js';
+import {
+  DEFAULT_NAME_MATCHING,
+  filterByName,
+  NameMatching,
+  resolveByName,
+} from './trello/name-matching.js';
+import { parseCardShortLink } from './trello/links.js';
+import { parseDefaultFields } from './card-fields.js';
+import {
+  positionRelativeTo,
+  sortCards,
+  CardSortKey,
+  POSITION_STEP,
+} from './trello/positions.js';
+import { mapWithConcurrency, partitionSettled, rejectionMessage } from './concurrency.js';
+import { assertCommentLength, renderMentions, splitComment } from './trello/comments.js';
+import {
+  buildListTimeline,
+  LIST_HISTORY_ACTION_TYPES,
+  ListStay,
+  summarizeAction,
+  SUMMARY_ACTION_TYPES,
+} from './trello/actions.js';
+import { resolvePowerUpId } from './trello/power-ups.js';
+import {
+  buildBoardExport,
+  BoardExport,
+  BoardImportSummary,
+  isWithinDirectory,
+  parseBoardExport,
+} from './trello/export.js';
+import { decodeCustomFieldItems, DecodedCustomFieldValue } from './trello/custom-fields.js';
 import { validateExternalUrl } from './url-validator.js';
+import { buildDueReport, DueReport } from './trello/due-report.js';
+import {
+  diffListSnapshot,
+  ListDiff,
+  ListSnapshot,
+  takeListSnapshot,
+} from './trello/list-snapshots.js';
 
 // Path for storing active board/workspace configuration
 const CONFIG_DIR = path.join(process.env.HOME || process.env.USERPROFILE || '.', '.trello-mcp');
 const CONFIG_FILE = path.join(CONFIG_DIR, 'config.json');
 
+const TRELLO_API_BASE_URL = 'https://api.trello.com/1';
+
 export class TrelloClient {
   private axiosInstance: AxiosInstance;
   private rateLimiter;
   private defaultBoardId?: string;
   private activeConfig: TrelloConfig;
+  private defaultFields: Record<string, string> = {};
+  private wipLimits: Record<string, number> = {};
+  /** Board ID -> list new cards go to when no listId is given */
+  private defaultLists: Record<string, string> = {};
+  /** The subset of defaultLists that was set with persist and belongs in the config file */
+  private persistedDefaultLists: Record<string, string> = {};
+  private retryOptions: RetryOptions;
+  private timeoutMs: number;
+  private recentCardCreations = new Map<string, { card: Promise<TrelloCard>; expiresAt: number }>();
+  private currentMember?: Promise<TrelloAuthenticatedMember>;
+  private emojiShortNames?: Promise<Set<string>>;
+  private memberDetails = new Map<string, Promise<TrelloMember>>();
+  private cardBoards = new Map<string, string>();
+  private listNames = new Map<string, Promise<string>>();
+  private boardNames = new Map<string, Promise<string>>();
+  private lastMove?: { cardId: string; idBoard: string; idList: string; pos: number };
+  private customFieldDefinitions = new Map<
+    string,
+    { definitions: Promise<TrelloCustomFieldDefinition[]>; expiresAt: number }
+  >();
+  private stats: TrelloClientStats = {
+    requests: 0,
+    retries: 0,
+    retriesExhausted: 0,
+    timeouts: 0,
+    failures: 0,
+    cacheHits: 0,
+    lastRetryAt: null,
+  };
+  private etagCache = new Map<string, { etag: string; data: unknown }>();
+  private listSnapshots = new Map<string, ListSnapshot>();
 
   constructor(private config: TrelloConfig) {
     this.defaultBoardId = config.defaultBoardId;
+    this.retryOptions = {
+      maxRetries: config.maxRetries ?? DEFAULT_RETRY_OPTIONS.maxRetries,
+      baseDelayMs: config.baseDelayMs ?? DEFAULT_RETRY_OPTIONS.baseDelayMs,
+      maxDelayMs: config.maxDelayMs ?? DEFAULT_RETRY_OPTIONS.maxDelayMs,
+    };
+    this.timeoutMs = config.timeoutMs ?? DEFAULT_TIMEOUT_MS;
     this.activeConfig = { ...config };
     // If boardId is provided in config, use it as the active board
     if (config.boardId && !this.activeConfig.boardId) {
@@ -50,7 +149,8 @@ export class TrelloClient {
       this.activeConfig.boardId = this.defaultBoardId;
     }
     const axiosConfig: CreateAxiosDefaults = {
-      baseURL: 'https://api.trello.com/1',
+      baseURL: TRELLO_API_BASE_URL,
+      timeout: this.timeoutMs,
       params: {
         key: config.apiKey,
         token: config.token,
@@ -74,6 +174,22 @@ export class TrelloClient {
       await this.rateLimiter.waitForAvailableToken();
       return config;
     });
+
+    // Any write makes the remembered move stale; moveCard records its own after the write
+    this.axiosInstance.interceptors.request.use(config => {
+      if (config.method && config.method.toLowerCase() !== 'get') {
+        this.lastMove = undefined;
+      }
+      return config;
+    });
+
+    // Registered last so it runs first: a malformed id never takes a rate limit token
+    this.axiosInstance.interceptors.request.use(config => {
+      if (config.url) {
+        assertWellFormedIds(config.url);
+      }
+      return config;
+    });
   }
 
   /**
@@ -92,9 +208,35 @@ export class TrelloClient {
       if (savedConfig.workspaceId) {
         this.activeConfig.workspaceId = savedConfig.workspaceId;
       }
+
+      // Validate every section before reporting, so one bad section does not
+      // leave the others (such as WIP limits) unloaded
+      const problems: string[] = [];
+      const section = <T>(value: unknown, parse: (value: unknown) => T): T | undefined => {
+        if (!value) {
+          return undefined;
+        }
+        try {
+          return parse(value);
+        } catch (error) {
+          problems.push(error instanceof Error ? error.message : String(error));
+          return undefined;
+        }
+      };
+      this.defaultFields =
+        section(savedConfig.defaultFields, parseDefaultFields) ?? this.defaultFields;
+      this.wipLimits = section(savedConfig.wipLimits, parseWipLimits) ?? this.wipLimits;
+      const defaultLists = section(savedConfig.defaultLists, parseDefaultLists);
+      if (defaultLists) {
+        this.persistedDefaultLists = defaultLists;
+        this.defaultLists = { ...defaultLists };
+      }
+      if (problems.length > 0) {
+        throw new Error(`Invalid configuration in ${CONFIG_FILE}: ${problems.join('; ')}`);
+      }
     } catch (error) {
       // File might not exist yet, that's okay
-      if (error instanceof Error && 'code' in error && error.code !== 'ENOENT') {
+      if (!(error instanceof Error && 'code' in error && error.code === 'ENOENT')) {
         throw error;
       }
     }
@@ -109,6 +251,11 @@ export class TrelloClient {
       const configToSave = {
         boardId: this.activeConfig.boardId,
         workspaceId: this.activeConfig.workspaceId,
+        ...(Object.keys(this.defaultFields).length > 0 && { defaultFields: this.defaultFields }),
+        ...(Object.keys(this.wipLimits).length > 0 && { wipLimits: this.wipLimits }),
+        ...(Object.keys(this.persistedDefaultLists).length > 0 && {
+          defaultLists: this.persistedDefaultLists,
+        }),
       };
       await fs.writeFile(CONFIG_FILE, JSON.stringify(configToSave, null, 2));
     } catch (error) {
@@ -131,6 +278,13 @@ export class TrelloClient {
     return this.activeConfig.workspaceId;
   }
 
+  /**
+   * Get the configured default card fields for a tool, if any
+   */
+  getDefaultFields(toolName: string): string | undefined {
+    return this.defaultFields[toolName];
+  }
+
   /**
    * Check if workspace restriction is enabled
    */
@@ -171,6 +325,153 @@ export class TrelloClient {
     return board;
   }
 
+  /**
+   * Get the board used when a tool is called without a boardId
+   */
+  get effectiveDefaultBoardId(): string | undefined {
+    return this.activeConfig.boardId || this.defaultBoardId;
+  }
+
+  /**
+   * The board a card is on, for operations that need a board when the caller
+   * only passed a card. An explicit boardId wins; otherwise the card is looked
+   * up once and remembered until it is moved.
+   */
+  async resolveCardBoardId(cardId: string, boardId?: string): Promise<string> {
+    if (boardId) {
+      return boardId;
+    }
+    const cached = this.cardBoards.get(cardId);
+    if (cached) {
+      return cached;
+    }
+    const { idBoard } = await this.getCardById(cardId, 'idBoard');
+    this.cardBoards.set(cardId, idBoard);
+    return idBoard;
+  }
+
+  /**
+   * How board, workspace, list, label, and checklist names are matched
+   */
+  get nameMatching(): NameMatching {
+    return this.config.nameMatching ?? DEFAULT_NAME_MATCHING;
+  }
+
+  /**
+   * Change the default board at runtime, by ID or by name.
+   * Only written to the config file when persist is set.
+   */
+  async setDefaultBoard(params: {
+    boardId?: string;
+    boardName?: string;
+    persist?: boolean;
+  }): Promise<TrelloBoard> {
+    if (!params.boardId && !params.boardName) {
+      throw new McpError(ErrorCode.InvalidParams, 'Either boardId or boardName is required');
+    }
+    const board = params.boardId
+      ? await this.getBoardById(params.boardId)
+      : await this.findBoardByName(params.boardName!);
+    this.defaultBoardId = board.id;
+    this.activeConfig.boardId = board.id;
+    if (params.persist) {
+      await this.saveConfig();
+    }
+    return board;
+  }
+
+  /**
+   * Make a list the one new cards go to on its board when no listId is given.
+   * Kept in memory only unless persist is set.
+   */
+  async setDefaultList(params: {
+    listId: string;
+    boardId?: string;
+    persist?: boolean;
+  }): Promise<TrelloList> {
+    const list = await this.getList(params.listId);
+    if (params.boardId && list.idBoard !== params.boardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `List "${list.name}" (${list.id}) is on board ${list.idBoard}, not ${params.boardId}`
+      );
+    }
+    if (list.closed) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `List "${list.name}" (${list.id}) is archived and cannot be the default list`
+      );
+    }
+    this.defaultLists[list.idBoard] = list.id;
+    if (params.persist) {
+      this.persistedDefaultLists[list.idBoard] = list.id;
+      await this.saveConfig();
+    }
+    return list;
+  }
+
+  /**
+   * The default list of a board (the default board when boardId is omitted), or
+   * null when none is set. A list that was archived or deleted since it was set
+   * is reported with a warning rather than an error.
+   */
+  async getDefaultList(boardId?: string): Promise<{
+    boardId: string;
+    list: { id: string; name: string | null; closed: boolean };
+    warning?: string;
+  } | null> {
+    const effectiveBoardId = boardId || this.effectiveDefaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    const listId = this.defaultLists[effectiveBoardId];
+    if (!listId) {
+      return null;
+    }
+    const list = await this.getList(listId).catch(error => {
+      // Trello answers 404 for a deleted list; anything else is a real failure
+      if (error instanceof McpError && /API Error: 40[04]\b/.test(error.message)) {
+        return undefined;
+      }
+      throw error;
+    });
+    if (!list || list.idBoard !== effectiveBoardId) {
+      return {
+        boardId: effectiveBoardId,
+        list: { id: listId, name: null, closed: false },
+        warning: `Default list ${listId} no longer exists on this board; set a new one with set_default_list.`,
+      };
+    }
+    return {
+      boardId: effectiveBoardId,
+      list: { id: list.id, name: list.name, closed: list.closed },
+      ...(list.closed && {
+        warning: `Default list "${list.name}" has been archived; set a new one with set_default_list.`,
+      }),
+    };
+  }
+
+  /**
+   * The list a new card goes to when the caller gave none: the board's default
+   * list, provided it still exists and is open
+   */
+  async resolveDefaultListId(boardId?: string): Promise<string> {
+    const result = await this.getDefaultList(boardId);
+    if (!result) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'listId is required when the board has no default list. Set one with set_default_list.'
+      );
+    }
+    if (result.warning) {
+      throw new McpError(ErrorCode.InvalidParams, `${result.warning} Or pass listId.`);
+    }
+    return result.list.id;
+  }
+
   /**
    * Set the active workspace
    * Validates against allowedWorkspaceIds if configured
@@ -186,23 +487,102 @@ export class TrelloClient {
     return workspace;
   }
 
-  private static readonly MAX_RETRY_ATTEMPTS = 3;
+  /**
+   * Snapshot of request/retry counters since the client was created
+   */
+  getStats(): TrelloClientStats & { retryOptions: RetryOptions; timeoutMs: number } {
+    return { ...this.stats, retryOptions: { ...this.retryOptions }, timeoutMs: this.timeoutMs };
+  }
+
+  /**
+   * Time a single unretried GET /members/me and report what came back, including
+   * Trello's x-rate-limit-* quota headers. Never throws.
+   */
+  async pingApi(): Promise<{
+    reachable: boolean;
+    credentialsValid: boolean | null;
+    httpStatus: number | null;
+    latency_ms: number;
+    apiBaseUrl: string;
+    rateLimit: Record<string, string>;
+    error?: string;
+  }> {
+    const started = Date.now();
+    const rateLimitHeaders = (headers: unknown): Record<string, string> =>
+      Object.fromEntries(
+        Object.entries((headers ?? {}) as Record<string, unknown>)
+          .filter(([name]) => name.toLowerCase().startsWith('x-rate-limit'))
+          .map(([name, value]) => [name.toLowerCase(), String(value)])
+      );
+    try {
+      const response = await this.axiosInstance.get('/members/me', { params: { fields: 'id' } });
+      return {
+        reachable: true,
+        credentialsValid: true,
+        httpStatus: response.status ?? 200,
+        latency_ms: Date.now() - started,
+        apiBaseUrl: TRELLO_API_BASE_URL,
+        rateLimit: rateLimitHeaders(response.headers),
+      };
+    } catch (error) {
+      const response = axios.isAxiosError(error) ? error.response : undefined;
+      const status = response?.status ?? null;
+      return {
+        reachable: response !== undefined,
+        credentialsValid: status === 401 ? false : null,
+        httpStatus: status,
+        latency_ms: Date.now() - started,
+        apiBaseUrl: TRELLO_API_BASE_URL,
+        rateLimit: rateLimitHeaders(response?.headers),
+        error: error instanceof Error ? error.message : String(error),
+      };
+    }
+  }
 
   // T is unconstrained on purpose: it only threads the caller's return type through.
   // A closed union here excluded every T[] and broke each new return shape.
   private async handleRequest<T>(requestFn: () => Promise<T>, retryCount: number = 0): Promise<T> {
+    if (retryCount === 0) {
+      this.stats.requests++;
+    }
     try {
       return await requestFn();
     } catch (error) {
+      // Validation errors raised inside a request already carry a useful message
+      if (error instanceof McpError) {
+        this.stats.failures++;
+        throw error;
+      }
+      if (isTimeoutError(error)) {
+        this.stats.timeouts++;
+        const retryable = isIdempotentRequest(error);
+        if (retryable && retryCount < this.retryOptions.maxRetries) {
+          this.stats.retries++;
+          this.stats.lastRetryAt = new Date().toISOString();
+          const delay = computeBackoffDelay(retryCount, this.retryOptions);
+          await new Promise(resolve => setTimeout(resolve, delay));
+          return this.handleRequest(requestFn, retryCount + 1);
+        }
+        this.stats.failures++;
+        if (retryable) {
+          this.stats.retriesExhausted++;
+        }
+        throw new TrelloTimeoutError(this.timeoutMs, retryCount + 1);
+      }
       if (axios.isAxiosError(error)) {
-        if (error.response?.status === 429 && retryCount < TrelloClient.MAX_RETRY_ATTEMPTS) {
-          await new Promise(resolve => setTimeout(resolve, 1000 * Math.pow(2, retryCount)));
+        if (error.response?.status === 429 && retryCount < this.retryOptions.maxRetries) {
+          this.stats.retries++;
+          this.stats.lastRetryAt = new Date().toISOString();
+          const delay = computeBackoffDelay(retryCount, this.retryOptions);
+          await new Promise(resolve => setTimeout(resolve, delay));
           return this.handleRequest(requestFn, retryCount + 1);
         }
+        this.stats.failures++;
         if (error.response?.status === 429) {
+          this.stats.retriesExhausted++;
           throw new McpError(
             ErrorCode.InternalError,
-            `Trello API rate limit exceeded after ${TrelloClient.MAX_RETRY_ATTEMPTS} retries`
+            `Trello API rate limit exceeded after ${this.retryOptions.maxRetries} retries`
           );
         }
         throw new McpError(
@@ -211,11 +591,53 @@ export class TrelloClient {
           error.response?.data
         );
       } else {
+        this.stats.failures++;
         throw new McpError(ErrorCode.InternalError, 'An unexpected error occurred');
       }
     }
   }
 
+  static readonly ETAG_CACHE_MAX_ENTRIES = 500;
+
+  /**
+   * GET with ETag revalidation: a repeat of an earlier request sends If-None-Match
+   * and a 304 reply is answered from the stored body. Must be called inside handleRequest.
+   */
+  private async getWithEtag<T>(
+    url: string,
+    config?: AxiosRequestConfig,
+    noCache: boolean = false
+  ): Promise<T> {
+    const key = `${url}?${JSON.stringify(config?.params ?? {})}`;
+    const cached = noCache ? undefined : this.etagCache.get(key);
+    let response;
+    if (cached) {
+      response = await this.axiosInstance.get(url, {
+        ...config,
+        headers: { ...config?.headers, 'If-None-Match': cached.etag },
+        validateStatus: status => (status >= 200 && status < 300) || status === 304,
+      });
+      if (response.status === 304) {
+        this.stats.cacheHits++;
+        return cached.data as T;
+      }
+    } else {
+      response = config
+        ? await this.axiosInstance.get(url, config)
+        : await this.axiosInstance.get(url);
+    }
+
+    const etag = response.headers?.etag;
+    if (typeof etag === 'string') {
+      this.etagCache.delete(key);
+      this.etagCache.set(key, { etag, data: response.data });
+      if (this.etagCache.size > TrelloClient.ETAG_CACHE_MAX_ENTRIES) {
+        this.etagCache.delete(this.etagCache.keys().next().value!);
+      }
+    }
+    return response.data;
+  }
+
   /**
    * List all boards the user has access to
    * If allowedWorkspaceIds is configured, only returns boards from allowed workspaces
@@ -233,6 +655,41 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * List the boards another member (by ID or username) belongs to, limited to what
+   * the token can see. Honors allowedWorkspaceIds like listBoards.
+   */
+  async getMemberBoards(
+    member: string,
+    options: { filter?: string; fields?: string } = {}
+  ): Promise<TrelloBoard[]> {
+    const memberId = member.trim().replace(/^@/, '');
+    // The workspace filter needs idOrganization even when the caller trimmed the fields
+    const fields =
+      this.hasWorkspaceRestriction &&
+      options.fields &&
+      !options.fields.split(',').includes('idOrganization')
+        ? `${options.fields},idOrganization`
+        : options.fields;
+    return this.handleRequest(async () => {
+      const params = {
+        ...(options.filter && { filter: options.filter }),
+        ...(fields && { fields }),
+      };
+      const response = await this.axiosInstance.get(
+        `/members/${encodeURIComponent(memberId)}/boards`,
+        { params }
+      );
+      const boards: TrelloBoard[] = response.data;
+      if (this.hasWorkspaceRestriction) {
+        return boards.filter(
+          board => board.idOrganization && this.isWorkspaceAllowed(board.idOrganization)
+        );
+      }
+      return boards;
+    });
+  }
+
   /**
    * Get a specific board by ID
    */
@@ -243,6 +700,33 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Archive (close) or reopen a board. Closing the board used as the default
+   * clears the default, and the saved active board, so later calls without a
+   * boardId fail clearly instead of writing to an archived board.
+   */
+  async setBoardClosed(
+    boardId: string,
+    closed: boolean
+  ): Promise<{ board: TrelloBoard; clearedDefault: boolean }> {
+    const board = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.put<TrelloBoard>(`/boards/${boardId}/closed`, {
+        value: closed,
+      });
+      return response.data;
+    });
+    const clearedDefault = closed && this.effectiveDefaultBoardId === boardId;
+    if (clearedDefault) {
+      const wasSaved = this.activeConfig.boardId === boardId;
+      this.defaultBoardId = undefined;
+      this.activeConfig.boardId = undefined;
+      if (wasSaved) {
+        await this.saveConfig();
+      }
+    }
+    return { board, clearedDefault };
+  }
+
   /**
    * List all workspaces the user has access to
    * If allowedWorkspaceIds is configured, only returns workspaces in that list
@@ -270,6 +754,185 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Recent notifications for the token's member, newest first. types narrows
+   * to notification types such as mentionedOnCard or cardDueSoon.
+   */
+  async getNotifications(
+    params: { types?: string[]; readFilter?: 'all' | 'unread'; limit?: number } = {}
+  ): Promise<NotificationSummary[]> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<TrelloNotification[]>(
+        '/members/me/notifications',
+        {
+          params: {
+            read_filter: params.readFilter ?? 'all',
+            limit: params.limit ?? 50,
+            ...(params.types?.length && { filter: params.types.join(',') }),
+          },
+        }
+      );
+      return response.data.map(summarizeNotification);
+    });
+  }
+
+  /**
+   * Mark one notification as read
+   */
+  async markNotificationRead(notificationId: string): Promise<NotificationSummary> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put<TrelloNotification>(
+        `/notifications/${notificationId}/unread`,
+        { value: false }
+      );
+      return summarizeNotification(response.data);
+    });
+  }
+
+  /**
+   * Mark notifications read: the given IDs one by one, or every unread
+   * notification at once. Trello's bulk endpoint returns no count, so unread
+   * notifications are counted first.
+   */
+  async markNotificationsRead(
+    params: { ids?: string[]; concurrency?: number } = {}
+  ): Promise<{ marked: number; failures: Array<{ notificationId: string; error: string }> }> {
+    if (params.ids?.length) {
+      const ids = params.ids;
+      const settled = await mapWithConcurrency(ids, this.bulkConcurrency(params.concurrency), id =>
+        this.markNotificationRead(id)
+      );
+      const failures = partitionSettled(ids, settled).rejected.map(({ item, error }) => ({
+        notificationId: item,
+        error,
+      }));
+      return { marked: ids.length - failures.length, failures };
+    }
+    return this.handleRequest(async () => {
+      const unread = await this.axiosInstance.get('/members/me/notifications', {
+        params: { read_filter: 'unread', fields: 'id', limit: 1000 },
+      });
+      await this.axiosInstance.post('/notifications/all/read');
+      return { marked: unread.data.length, failures: [] };
+    });
+  }
+
+  /**
+   * The member the token belongs to. Fetched once per session; a failed lookup is retried next call.
+   */
+  async whoami(): Promise<TrelloAuthenticatedMember> {
+    if (!this.currentMember) {
+      this.currentMember = this.handleRequest(async () => {
+        const response = await this.axiosInstance.get('/members/me', {
+          params: { fields: 'id,username,fullName,email' },
+        });
+        const { id, username, fullName, email } = response.data;
+        return { id, username, fullName, email: email ?? null };
+      });
+      this.currentMember.catch(() => {
+        this.currentMember = undefined;
+      });
+    }
+    return this.currentMember;
+  }
+
+  /**
+   * Find an accessible workspace by display name or short name
+   */
+  async findWorkspaceByName(name: string): Promise<TrelloWorkspace> {
+    return resolveByName(await this.listWorkspaces(), name, {
+      kind: 'Workspace',
+      names: ws => [ws.displayName, ws.name],
+      mode: this.nameMatching,
+      idParam: 'idOrganization',
+      notFoundHint: 'Use list_workspaces to see available workspaces.',
+    });
+  }
+
+  /**
+   * Find an open, accessible board by name
+   */
+  async findBoardByName(name: string): Promise<TrelloBoard> {
+    const boards = (await this.listBoards()).filter(board => !board.closed);
+    return resolveByName(boards, name, {
+      kind: 'Board',
+      names: board => [board.name],
+      mode: this.nameMatching,
+      idParam: 'boardId',
+      notFoundHint: 'Use list_boards to see available boards.',
+    });
+  }
+
+  /**
+   * Resolve an open board by name, optionally narrowed to a
+   * workspace given by ID, display name, or short name. When the name is still
+   * ambiguous, returns every candidate with its workspace instead of guessing.
+   */
+  async getBoardByName(
+    name: string,
+    workspace?: string
+  ): Promise<
+    | { board: TrelloBoard; workspaceName: string | null }
+    | {
+        board: null;
+        candidates: Array<{
+          id: string;
+          name: string;
+          url: string;
+          workspaceId: string | null;
+          workspaceName: string | null;
+        }>;
+      }
+  > {
+    const [boards, workspaces] = await Promise.all([this.listBoards(), this.listWorkspaces()]);
+    const workspaceNames = new Map(workspaces.map(ws => [ws.id, ws.displayName]));
+    let matches = filterByName(
+      boards.filter(board => !board.closed),
+      name,
+      board => [board.name],
+      this.nameMatching
+    );
+
+    if (workspace) {
+      const workspaceIds = new Set(
+        [
+          ...workspaces.filter(ws => ws.id === workspace.trim()),
+          ...filterByName(workspaces, workspace, ws => [ws.displayName, ws.name], this.nameMatching),
+        ].map(ws => ws.id)
+      );
+      if (workspaceIds.size === 0) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          `Workspace "${workspace}" not found. Use list_workspaces to see available workspaces.`
+        );
+      }
+      matches = matches.filter(board => workspaceIds.has(board.idOrganization));
+    }
+
+    if (matches.length === 0) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Board "${name}" not found${workspace ? ` in workspace "${workspace}"` : ''}. Use list_boards to see available boards.`
+      );
+    }
+    if (matches.length === 1) {
+      return {
+        board: matches[0],
+        workspaceName: workspaceNames.get(matches[0].idOrganization) ?? null,
+      };
+    }
+    return {
+      board: null,
+      candidates: matches.map(board => ({
+        id: board.id,
+        name: board.name,
+        url: board.url,
+        workspaceId: board.idOrganization ?? null,
+        workspaceName: workspaceNames.get(board.idOrganization) ?? null,
+      })),
+    };
+  }
+
   /**
    * List boards in a specific workspace
    * Validates against allowedWorkspaceIds if configured
@@ -286,17 +949,29 @@ export class TrelloClient {
 
   /**
    * Create a new board
-   * Validates target workspace against allowedWorkspaceIds if configured
+   * Validates target workspace against allowedWorkspaceIds if configured.
+   * When labels are given they are created right after the board; label
+   * failures are reported alongside the board rather than failing the call.
    */
   async createBoard(params: {
     name: string;
     desc?: string;
     idOrganization?: string;
+    workspaceName?: string;
     defaultLabels?: boolean;
     defaultLists?: boolean;
-  }): Promise<TrelloBoard> {
+    labels?: Array<{ name: string; color?: string }>;
+  }): Promise<
+    TrelloBoard & {
+      labels?: TrelloLabelDetails[];
+      labelErrors?: Array<{ name: string; error: string }>;
+    }
+  > {
     // Determine the target workspace
-    const targetWorkspace = params.idOrganization ?? this.activeConfig.workspaceId;
+    let targetWorkspace = params.idOrganization ?? this.activeConfig.workspaceId;
+    if (!params.idOrganization && params.workspaceName) {
+      targetWorkspace = (await this.findWorkspaceByName(params.workspaceName)).id;
+    }
 
     // When workspace restrictions are enabled, require a valid workspace
     if (this.hasWorkspaceRestriction) {
@@ -309,7 +984,7 @@ export class TrelloClient {
       this.validateWorkspaceAccess(targetWorkspace);
     }
 
-    return this.handleRequest(async () => {
+    const board: TrelloBoard = await this.handleRequest(async () => {
       const response = await this.axiosInstance.post('/boards', {
         name: params.name,
         desc: params.desc,
@@ -319,38 +994,377 @@ export class TrelloClient {
       });
       return response.data;
     });
+    if (!params.labels || params.labels.length === 0) {
+      return board;
+    }
+
+    const labelSpecs = params.labels;
+    const settled = await mapWithConcurrency(labelSpecs, TrelloClient.BULK_CONCURRENCY, label =>
+      this.createLabel(board.id, label.name, label.color)
+    );
+    const { fulfilled, rejected } = partitionSettled(labelSpecs, settled);
+    const labels = fulfilled.map(({ value }) => value);
+    const labelErrors = rejected.map(({ item, error }) => ({ name: item.name, error }));
+    return { ...board, labels, ...(labelErrors.length > 0 && { labelErrors }) };
   }
 
-  async getCardsByList(
-    listId: string,
-    fields?: string,
-    nameFilter?: string
-  ): Promise<TrelloCard[]> {
-    return this.handleRequest(async () => {
-      const params = fields ? { fields } : {};
-      const response = await this.axiosInstance.get(`/lists/${listId}/cards`, { params });
-      let cards: TrelloCard[] = response.data;
-      const trimmed = nameFilter?.trim();
-      if (trimmed) {
-        const searchTerm = trimmed.toLowerCase();
-        cards = cards.filter((card) => card.name.toLowerCase().includes(searchTerm));
+  /**
+   * Copy a board's lists and labels (and optionally its cards) into a new board.
+   * Validates the target workspace against allowedWorkspaceIds like createBoard.
+   */
+  async copyBoard(params: {
+    sourceBoardId: string;
+    name: string;
+    idOrganization?: string;
+    keepCards?: boolean;
+  }): Promise<TrelloBoard> {
+    const targetWorkspace = params.idOrganization ?? this.activeConfig.workspaceId;
+    if (this.hasWorkspaceRestriction) {
+      if (!targetWorkspace) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          `Workspace restrictions are enabled but no workspace was specified. Provide idOrganization or set an active workspace. Allowed workspaces: ${this.config.allowedWorkspaceIds!.join(', ')}`
+        );
       }
-      return cards;
+      this.validateWorkspaceAccess(targetWorkspace);
+    }
+
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.post('/boards', {
+        name: params.name,
+        idBoardSource: params.sourceBoardId,
+        keepFromSource: params.keepCards === false ? 'none' : 'cards',
+        idOrganization: targetWorkspace,
+      });
+      return response.data;
     });
   }
 
-  async getLists(boardId?: string): Promise<TrelloList[]> {
-    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
-    if (!effectiveBoardId) {
+  /**
+   * Open boards the token can see that are marked as templates
+   */
+  async listBoardTemplates(): Promise<
+    Array<{ id: string; name: string; desc: string; url: string; idOrganization: string | null }>
+  > {
+    const boards = await this.listBoards();
+    return boards
+      .filter(board => !board.closed && board.prefs?.isTemplate === true)
+      .map(board => ({
+        id: board.id,
+        name: board.name,
+        desc: board.desc,
+        url: board.url,
+        idOrganization: board.idOrganization ?? null,
+      }));
+  }
+
+  /**
+   * Create a board from a template board. Refuses boards that are not marked
+   * as templates, so an ordinary board is not copied by mistake.
+   */
+  async createBoardFromTemplate(params: {
+    templateBoardId: string;
+    name: string;
+    idOrganization?: string;
+    keepCards?: boolean;
+  }): Promise<TrelloBoard> {
+    const template = await this.getBoardById(params.templateBoardId);
+    if (template.prefs?.isTemplate !== true) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Board "${template.name}" (${template.id}) is not a template. Use copy_board to copy an ordinary board.`
+      );
+    }
+    return this.copyBoard({
+      sourceBoardId: template.id,
+      name: params.name,
+      idOrganization: params.idOrganization,
+      keepCards: params.keepCards,
+    });
+  }
+
+  static readonly BOARD_BACKGROUND_COLORS = [
+    'blue',
+    'orange',
+    'green',
+    'red',
+    'purple',
+    'pink',
+    'lime',
+    'sky',
+    'grey',
+  ] as const;
+  static readonly BOARD_PERMISSION_LEVELS = ['org', 'private', 'public'] as const;
+  static readonly BOARD_AUDIENCE_LEVELS = ['disabled', 'members', 'observers', 'org', 'public'] as const;
+
+  /**
+   * Update board preferences (background, card covers, voting/commenting permissions, visibility).
+   * Trello accepts these as `prefs/<name>` fields on PUT /boards/{id}; only provided fields are sent.
+   */
+  async updateBoardPreferences(
+    boardId: string | undefined,
+    prefs: {
+      background?: string;
+      cardCovers?: boolean;
+      voting?: TrelloBoardPrefs['voting'];
+      comments?: TrelloBoardPrefs['comments'];
+      permissionLevel?: TrelloBoardPrefs['permissionLevel'];
+    }
+  ): Promise<TrelloBoardPrefs> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    const body = Object.fromEntries(
+      Object.entries(prefs)
+        .filter(([, value]) => value !== undefined)
+        .map(([key, value]) => [`prefs/${key}`, value])
+    );
+    if (Object.keys(body).length === 0) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'At least one of background, cardCovers, voting, comments, or permissionLevel must be provided'
+      );
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put<TrelloBoard>(`/boards/${effectiveBoardId}`, body);
+      return response.data.prefs as TrelloBoardPrefs;
+    });
+  }
+
+  /**
+   * The preferences updateBoardPreferences can change, plus the background details,
+   * read with fields=prefs. Missing values come back as null rather than absent.
+   */
+  async getBoardPreferences(boardId?: string): Promise<{
+    permissionLevel: TrelloBoardPrefs['permissionLevel'] | null;
+    voting: TrelloBoardPrefs['voting'] | null;
+    comments: TrelloBoardPrefs['comments'] | null;
+    cardCovers: boolean | null;
+    background: string | null;
+    backgroundColor: string | null;
+    backgroundImage: string | null;
+    isTemplate: boolean;
+  }> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
       throw new McpError(
         ErrorCode.InvalidParams,
         'boardId is required when no default board is configured'
       );
     }
     return this.handleRequest(async () => {
-      const response = await this.axiosInstance.get(`/boards/${effectiveBoardId}/lists`);
+      const response = await this.axiosInstance.get<TrelloBoard>(`/boards/${effectiveBoardId}`, {
+        params: { fields: 'prefs' },
+      });
+      const prefs: Partial<TrelloBoardPrefs> = response.data.prefs ?? {};
+      return {
+        permissionLevel: prefs.permissionLevel ?? null,
+        voting: prefs.voting ?? null,
+        comments: prefs.comments ?? null,
+        cardCovers: prefs.cardCovers ?? null,
+        background: prefs.background ?? null,
+        backgroundColor: prefs.backgroundColor ?? null,
+        backgroundImage: prefs.backgroundImage ?? null,
+        isTemplate: prefs.isTemplate === true,
+      };
+    });
+  }
+
+  /**
+   * The board's email-to-board address for the token's member, with the list and
+   * position emailed cards are created at. The address is per member, so it is read
+   * from the member's board prefs rather than the board itself.
+   */
+  async getBoardEmailAddress(boardId?: string): Promise<{
+    email: string | null;
+    emailKey: string | null;
+    defaultList: { id: string; name: string } | null;
+    position: string | null;
+  }> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    const myPrefs = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<{
+        fullEmail?: string;
+        emailKey?: string;
+        idEmailList?: string;
+        emailPosition?: string;
+      }>(`/boards/${effectiveBoardId}/myPrefs`);
       return response.data;
     });
+    const list = myPrefs.idEmailList
+      ? (await this.getLists(effectiveBoardId)).find(l => l.id === myPrefs.idEmailList)
+      : undefined;
+    return {
+      email: myPrefs.fullEmail ?? null,
+      emailKey: myPrefs.emailKey ?? null,
+      defaultList: list ? { id: list.id, name: list.name } : null,
+      position: myPrefs.emailPosition ?? null,
+    };
+  }
+
+  /**
+   * Power-Ups enabled on a board
+   */
+  async getBoardPlugins(boardId?: string): Promise<TrelloBoardPlugin[]> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/boards/${effectiveBoardId}/boardPlugins`);
+      return response.data;
+    });
+  }
+
+  /**
+   * Enable a Power-Up by plugin ID or well-known name. Already enabled Power-Ups are left alone.
+   * Returns the board's Power-Ups afterwards.
+   */
+  async enablePowerUp(boardId: string | undefined, powerUp: string): Promise<TrelloBoardPlugin[]> {
+    const idPlugin = resolvePowerUpId(powerUp);
+    const plugins = await this.getBoardPlugins(boardId);
+    if (plugins.some(plugin => plugin.idPlugin === idPlugin)) {
+      return plugins;
+    }
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    await this.handleRequest(async () => {
+      const response = await this.axiosInstance.post(`/boards/${effectiveBoardId}/boardPlugins`, {
+        idPlugin,
+      });
+      return response.data;
+    });
+    return this.getBoardPlugins(effectiveBoardId);
+  }
+
+  /**
+   * Disable a Power-Up by plugin ID or well-known name. Returns the board's Power-Ups afterwards.
+   */
+  async disablePowerUp(boardId: string | undefined, powerUp: string): Promise<TrelloBoardPlugin[]> {
+    const idPlugin = resolvePowerUpId(powerUp);
+    const plugins = await this.getBoardPlugins(boardId);
+    if (!plugins.some(plugin => plugin.idPlugin === idPlugin)) {
+      return plugins;
+    }
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    await this.handleRequest(async () => {
+      await this.axiosInstance.delete(`/boards/${effectiveBoardId}/boardPlugins/${idPlugin}`);
+    });
+    return this.getBoardPlugins(effectiveBoardId);
+  }
+
+
+  async getCardsByList(
+    listId: string,
+    fields?: string,
+    nameFilter?: string,
+    labelFilter?: { labels: string[]; mode?: 'any' | 'all'; boardId?: string }
+  ): Promise<TrelloCard[]> {
+    const labelGroups =
+      labelFilter && labelFilter.labels.length > 0
+        ? await this.resolveLabelFilter(listId, labelFilter.labels, labelFilter.boardId)
+        : undefined;
+    // The label filter needs idLabels even when the caller trimmed the fields
+    const requestFields =
+      labelGroups && fields && !fields.split(',').includes('idLabels') ? `${fields},idLabels` : fields;
+
+    return this.handleRequest(async () => {
+      const params = requestFields ? { fields: requestFields } : {};
+      const response = await this.axiosInstance.get(`/lists/${listId}/cards`, { params });
+      let cards: TrelloCard[] = response.data;
+      const trimmed = nameFilter?.trim();
+      if (trimmed) {
+        const searchTerm = trimmed.toLowerCase();
+        cards = cards.filter((card) => card.name.toLowerCase().includes(searchTerm));
+      }
+      if (labelGroups) {
+        const matchesGroup = (card: TrelloCard, group: Set<string>) =>
+          (card.idLabels ?? []).some(id => group.has(id));
+        cards = cards.filter(card =>
+          labelFilter!.mode === 'all'
+            ? labelGroups.every(group => matchesGroup(card, group))
+            : labelGroups.some(group => matchesGroup(card, group))
+        );
+      }
+      return cards;
+    });
+  }
+
+  /**
+   * Turn label IDs and color names into sets of label IDs, one set per requested entry.
+   * A color matches every board label of that color. Board labels are only fetched
+   * when a color name is present.
+   */
+  private async resolveLabelFilter(
+    listId: string,
+    labels: string[],
+    boardId?: string
+  ): Promise<Set<string>[]> {
+    if (labels.every(label => isTrelloId(label))) {
+      return labels.map(id => new Set([id]));
+    }
+    const effectiveBoardId = boardId || (await this.getList(listId)).idBoard;
+    const boardLabels = await this.getBoardLabels(effectiveBoardId);
+    return labels.map(entry => {
+      if (isTrelloId(entry)) {
+        return new Set([entry]);
+      }
+      const ids = boardLabels
+        .filter(label => label.color?.toLowerCase() === entry.toLowerCase())
+        .map(label => label.id);
+      if (ids.length === 0) {
+        throw new McpError(ErrorCode.InvalidParams, `No label with color "${entry}" on this board`);
+      }
+      return new Set(ids);
+    });
+  }
+
+  /**
+   * Count the cards in a list, fetching only card IDs. When boardId is given the
+   * list is checked against it.
+   */
+  async getListCardsCount(
+    listId: string,
+    options: { boardId?: string; includeClosed?: boolean } = {}
+  ): Promise<number> {
+    if (options.boardId) {
+      await this.assertListOnBoard(listId, options.boardId);
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/lists/${listId}/cards`, {
+        params: { fields: 'id', filter: options.includeClosed ? 'all' : 'open' },
+      });
+      return (response.data as Array<{ id: string }>).length;
+    });
+  }
+
+  async getLists(boardId?: string, options: { noCache?: boolean } = {}): Promise<TrelloList[]> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    return this.handleRequest(() =>
+      this.getWithEtag<TrelloList[]>(
+        `/boards/${effectiveBoardId}/lists`,
+        undefined,
+        options.noCache
+      )
+    );
   }
 
   async getRecentActivity(boardId?: string, limit: number = 10, since?: string, before?: string): Promise<TrelloAction[]> {
@@ -372,6 +1386,13 @@ export class TrelloClient {
     });
   }
 
+  static readonly IDEMPOTENCY_TTL_MS = 10 * 60 * 1000;
+
+  /**
+   * Create a card. When an idempotencyKey is given, a repeat call with the same key
+   * within IDEMPOTENCY_TTL_MS returns the card from the first call instead of posting again.
+   * Failed creations are forgotten so they can be retried.
+   */
   async addCard(
     boardId: string | undefined,
     params: {
@@ -382,8 +1403,79 @@ export class TrelloClient {
       dueReminder?: number | null;
       start?: string;
       labels?: string[];
+      members?: string[];
+      idempotencyKey?: string;
     }
   ): Promise<TrelloCard> {
+    const key = params.idempotencyKey;
+    if (!key) {
+      return this.postCard(params);
+    }
+
+    const now = Date.now();
+    for (const [existingKey, entry] of this.recentCardCreations) {
+      if (entry.expiresAt <= now) {
+        this.recentCardCreations.delete(existingKey);
+      }
+    }
+    const existing = this.recentCardCreations.get(key);
+    if (existing) {
+      return existing.card;
+    }
+
+    const card = this.postCard(params);
+    this.recentCardCreations.set(key, { card, expiresAt: now + TrelloClient.IDEMPOTENCY_TTL_MS });
+    card.catch(() => this.recentCardCreations.delete(key));
+    return card;
+  }
+
+  /**
+   * Reject an unreadable start or due date, and a start date that falls after
+   * the due date when both are given
+   */
+  private static assertStartNotAfterDue(
+    start: string | null | undefined,
+    due: string | null | undefined
+  ): void {
+    const startMs = TrelloClient.parseCardDate('start', start);
+    const dueMs = TrelloClient.parseCardDate('due', due);
+    if (startMs !== undefined && dueMs !== undefined && startMs > dueMs) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `start (${start}) must not be after the due date (${due})`
+      );
+    }
+  }
+
+  /**
+   * Milliseconds for a start or due date, rejecting text Date.parse cannot read
+   */
+  private static parseCardDate(
+    field: string,
+    value: string | null | undefined
+  ): number | undefined {
+    if (!value) return undefined;
+    const ms = Date.parse(value);
+    if (!Number.isFinite(ms)) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `${field} "${value}" is not a valid date; use ISO 8601, e.g. 2024-06-01 or 2024-06-01T09:00:00Z`
+      );
+    }
+    return ms;
+  }
+
+  private async postCard(params: {
+    listId: string;
+    name: string;
+    description?: string;
+    dueDate?: string;
+    dueReminder?: number | null;
+    start?: string;
+    labels?: string[];
+    members?: string[];
+  }): Promise<TrelloCard> {
+    TrelloClient.assertStartNotAfterDue(params.start, params.dueDate);
     return this.handleRequest(async () => {
       const response = await this.axiosInstance.post('/cards', {
         idList: params.listId,
@@ -393,6 +1485,7 @@ export class TrelloClient {
         dueReminder: params.dueReminder,
         start: params.start,
         idLabels: params.labels,
+        ...(params.members && { idMembers: params.members }),
       });
       return response.data;
     });
@@ -412,6 +1505,7 @@ export class TrelloClient {
       pos?: string | number;
     }
   ): Promise<TrelloCard> {
+    TrelloClient.assertStartNotAfterDue(params.start, params.dueDate);
     return this.handleRequest(async () => {
       const response = await this.axiosInstance.put(`/cards/${params.cardId}`, {
         name: params.name,
@@ -427,6 +1521,125 @@ export class TrelloClient {
     });
   }
 
+  /** Reminder offsets, in minutes before the due date, that Trello offers; -1 is none */
+  static readonly DUE_REMINDER_MINUTES = [-1, 0, 5, 10, 15, 60, 120, 1440, 2880] as const;
+
+  /**
+   * Set how many minutes before its due date a card reminds its members
+   */
+  async setCardDueReminder(
+    boardId: string | undefined,
+    cardId: string,
+    reminderMinutes: number
+  ): Promise<{
+    cardId: string;
+    due: string | null;
+    dueReminder: number | null;
+    warning?: string;
+  }> {
+    if (!(TrelloClient.DUE_REMINDER_MINUTES as readonly number[]).includes(reminderMinutes)) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Invalid reminderMinutes ${reminderMinutes}. Allowed values: ${TrelloClient.DUE_REMINDER_MINUTES.join(', ')} (-1 for none)`
+      );
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put<TrelloCard & { dueReminder?: number | null }>(
+        `/cards/${cardId}`,
+        { dueReminder: reminderMinutes }
+      );
+      const card = response.data;
+      return {
+        cardId: card.id,
+        due: card.due ?? null,
+        dueReminder: card.dueReminder ?? null,
+        ...(!card.due &&
+          reminderMinutes !== -1 && {
+            warning: 'The card has no due date, so the reminder will not fire until one is set.',
+          }),
+      };
+    });
+  }
+
+  /**
+   * PUT only the provided card fields, using Trello's own field names.
+   * dueComplete needs a due date, either in the same update or already on the card.
+   * Moving to another list through idList honors WIP limits like moveCard.
+   */
+  async patchCard(
+    cardId: string,
+    fields: {
+      name?: string;
+      desc?: string;
+      due?: string | null;
+      start?: string | null;
+      dueComplete?: boolean;
+      idList?: string;
+      pos?: string | number;
+      closed?: boolean;
+      idLabels?: string[];
+      idMembers?: string[];
+    },
+    options: { override?: boolean } = {}
+  ): Promise<TrelloCard> {
+    const body = Object.fromEntries(
+      Object.entries(fields).filter(([, value]) => value !== undefined)
+    );
+    if (Object.keys(body).length === 0) {
+      throw new McpError(ErrorCode.InvalidParams, 'At least one card field must be provided');
+    }
+    TrelloClient.assertStartNotAfterDue(fields.start, fields.due);
+    if (fields.dueComplete !== undefined && fields.due === null) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'dueComplete cannot be set while clearing the due date'
+      );
+    }
+
+    // The checks below compare against the card as it is; fetch it at most once
+    let current: TrelloCard | undefined;
+    const currentCard = async (): Promise<TrelloCard> => {
+      if (!current) {
+        current = await this.getCardById(cardId, 'due,start,idList');
+      }
+      return current;
+    };
+    if (fields.start && fields.due === undefined) {
+      TrelloClient.assertStartNotAfterDue(fields.start, (await currentCard()).due);
+    }
+    if (fields.due && fields.start === undefined) {
+      TrelloClient.assertStartNotAfterDue((await currentCard()).start, fields.due);
+    }
+    if (fields.dueComplete !== undefined && fields.due === undefined) {
+      if (!(await currentCard()).due) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          'dueComplete requires a due date; the card has none, so pass due as well'
+        );
+      }
+    }
+    if (fields.idList !== undefined && !options.override) {
+      if ((await currentCard()).idList !== fields.idList) {
+        await this.assertWithinWipLimit(fields.idList);
+      }
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put(`/cards/${cardId}`, body);
+      return response.data;
+    });
+  }
+
+  /**
+   * Get a card's basic fields without the expansions done by getCard
+   */
+  async getCardById(cardId: string, fields?: string): Promise<TrelloCard> {
+    return this.handleRequest(async () => {
+      const params = fields ? { fields } : {};
+      const response = await this.axiosInstance.get(`/cards/${cardId}`, { params });
+      return response.data;
+    });
+  }
+
   async archiveCard(boardId: string | undefined, cardId: string): Promise<TrelloCard> {
     return this.handleRequest(async () => {
       const response = await this.axiosInstance.put(`/cards/${cardId}`, {
@@ -436,19 +1649,173 @@ export class TrelloClient {
     });
   }
 
-  async moveCard(boardId: string | undefined, cardId: string, listId: string, pos?: string | number): Promise<TrelloCard> {
-    const effectiveBoardId = boardId || this.defaultBoardId;
+  /**
+   * Bring an archived card back to its list
+   */
+  async unarchiveCard(cardId: string): Promise<TrelloCard> {
     return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put(`/cards/${cardId}`, { closed: false });
+      return response.data;
+    });
+  }
+
+  /**
+   * Archived cards in a list, most recently active first, which for archived cards
+   * is usually the most recently archived
+   */
+  async getArchivedCards(
+    listId: string,
+    limit: number = 50
+  ): Promise<Array<{ id: string; name: string; dateLastActivity: string; url: string }>> {
+    const cards = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<TrelloCard[]>(`/lists/${listId}/cards`, {
+        params: { filter: 'closed', fields: 'name,dateLastActivity,url' },
+      });
+      return response.data;
+    });
+    return sortCards(cards, 'dateLastActivity', 'desc')
+      .slice(0, limit)
+      .map(card => ({
+        id: card.id,
+        name: card.name,
+        dateLastActivity: card.dateLastActivity,
+        url: card.url,
+      }));
+  }
+
+  async moveCard(
+    boardId: string | undefined,
+    cardId: string,
+    listId: string,
+    pos?: string | number,
+    options: { override?: boolean } = {}
+  ): Promise<TrelloCard> {
+    const effectiveBoardId = boardId || this.defaultBoardId;
+    // Remember where the card was so undoLastMove can put it back; undo is best-effort
+    const previous = await this.getCardById(cardId, 'idBoard,idList,pos').catch(() => undefined);
+    if (!options.override && previous?.idList !== listId) {
+      await this.assertWithinWipLimit(listId);
+    }
+    const card = await this.handleRequest(async () => {
       const response = await this.axiosInstance.put(`/cards/${cardId}`, {
         idList: listId,
         ...(effectiveBoardId && { idBoard: effectiveBoardId }),
         ...(pos !== undefined && { pos }),
       });
+      return response.data as TrelloCard;
+    });
+    this.cardBoards.delete(cardId);
+    if (previous) {
+      this.lastMove = {
+        cardId,
+        idBoard: previous.idBoard,
+        idList: previous.idList,
+        pos: previous.pos,
+      };
+    }
+    return card;
+  }
+
+  /**
+   * Refuse to add a card to a list that already holds as many open cards as its
+   * configured WIP limit allows
+   */
+  private async assertWithinWipLimit(listId: string): Promise<void> {
+    if (Object.keys(this.wipLimits).length === 0) {
+      return;
+    }
+    const list = await this.getList(listId);
+    const limit = findWipLimit(this.wipLimits, list.name, this.nameMatching);
+    if (limit === undefined) {
+      return;
+    }
+    const count = await this.getListCardsCount(listId);
+    if (count >= limit) {
+      throw new McpError(
+        ErrorCode.InvalidRequest,
+        `List "${list.name}" is at its WIP limit (${count} of ${limit} cards). Pass override: true to move the card anyway.`,
+        { listId, listName: list.name, limit, count }
+      );
+    }
+  }
+
+  /**
+   * Move a card to the top or bottom of the list it is already in. Recorded like
+   * moveCard so undoLastMove can restore the old position.
+   */
+  async moveCardToListEdge(
+    cardId: string,
+    edge: 'top' | 'bottom'
+  ): Promise<{ cardId: string; idList: string; pos: number }> {
+    const previous = await this.getCardById(cardId, 'idBoard,idList,pos');
+    const card = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.put(`/cards/${cardId}`, { pos: edge });
+      return response.data as TrelloCard;
+    });
+    this.lastMove = {
+      cardId,
+      idBoard: previous.idBoard,
+      idList: previous.idList,
+      pos: previous.pos,
+    };
+    return { cardId, idList: card.idList, pos: card.pos };
+  }
+
+  /**
+   * Put the most recently moved card back where it was. Only one move is remembered,
+   * and any other write in between discards it.
+   */
+  async undoLastMove(): Promise<TrelloCard> {
+    const move = this.lastMove;
+    if (!move) {
+      throw new McpError(
+        ErrorCode.InvalidRequest,
+        'Nothing to undo: no card has been moved since the last change'
+      );
+    }
+    this.cardBoards.delete(move.cardId);
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put(`/cards/${move.cardId}`, {
+        idList: move.idList,
+        idBoard: move.idBoard,
+        pos: move.pos,
+      });
       return response.data;
     });
   }
 
-  async addList(boardId: string | undefined, name: string): Promise<TrelloList> {
+  /**
+   * Compute the pos that puts a card directly above or below another card in the target list
+   */
+  async computeRelativeCardPosition(
+    cardId: string,
+    listId: string,
+    relativeTo: string,
+    placement: 'above' | 'below'
+  ): Promise<number> {
+    if (relativeTo === cardId) {
+      throw new McpError(ErrorCode.InvalidParams, 'relativeTo must reference a different card');
+    }
+    const reference = await this.getCardById(relativeTo, 'idList,pos');
+    if (reference.idList !== listId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Reference card ${relativeTo} is in list ${reference.idList}, not the target list ${listId}. Move relative to a card in the target list, or set listId to ${reference.idList}.`
+      );
+    }
+    const siblings = await this.getCardsByList(listId, 'pos');
+    return positionRelativeTo(
+      siblings.filter(card => card.id !== cardId),
+      relativeTo,
+      placement
+    );
+  }
+
+  async addList(
+    boardId: string | undefined,
+    name: string,
+    options: { pos?: 'top' | 'bottom' | number; idListSource?: string } = {}
+  ): Promise<TrelloList> {
     const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
     if (!effectiveBoardId) {
       throw new McpError(
@@ -456,11 +1823,213 @@ export class TrelloClient {
         'boardId is required when no default board is configured'
       );
     }
-    return this.handleRequest(async () => {
-      const response = await this.axiosInstance.post('/lists', {
-        name,
-        idBoard: effectiveBoardId,
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.post('/lists', {
+        name,
+        idBoard: effectiveBoardId,
+        ...(options.pos !== undefined && { pos: options.pos }),
+        ...(options.idListSource && { idListSource: options.idListSource }),
+      });
+      return response.data;
+    });
+  }
+
+  /**
+   * Create a list after checking the board exists, optionally positioned and
+   * copied from a source list
+   */
+  async createList(params: {
+    boardId?: string;
+    name: string;
+    pos?: 'top' | 'bottom' | number;
+    idListSource?: string;
+  }): Promise<TrelloList> {
+    const effectiveBoardId = params.boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    await this.getBoardById(effectiveBoardId).catch(error => {
+      // Trello answers 400/404 for an unknown board; rate limits, network and auth
+      // failures keep their own error
+      if (error instanceof McpError && /API Error: 40[04]\b/.test(error.message)) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          `Board ${effectiveBoardId} not found or not accessible`
+        );
+      }
+      throw error;
+    });
+    return this.addList(effectiveBoardId, params.name, {
+      pos: params.pos,
+      idListSource: params.idListSource,
+    });
+  }
+
+  /**
+   * Duplicate a list, including its cards, via Trello's idListSource copy.
+   * Defaults to the source list's board. When copying across boards, compares
+   * labelled cards before and after so callers learn when labels did not carry over.
+   */
+  async duplicateList(params: {
+    sourceListId: string;
+    name: string;
+    boardId?: string;
+    position?: string | number;
+  }): Promise<{ list: TrelloList; cardCount: number; warnings: string[] }> {
+    const sourceList = await this.getList(params.sourceListId);
+    const targetBoardId = params.boardId || sourceList.idBoard;
+
+    const list = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.post<TrelloList>('/lists', {
+        name: params.name,
+        idBoard: targetBoardId,
+        idListSource: params.sourceListId,
+        ...(params.position !== undefined && { pos: params.position }),
+      });
+      return response.data;
+    });
+
+    const copiedCards = await this.getCardsByList(list.id, 'name,idLabels');
+    const warnings: string[] = [];
+    if (targetBoardId !== sourceList.idBoard) {
+      const sourceCards = await this.getCardsByList(params.sourceListId, 'name,idLabels');
+      const labelled = sourceCards.filter(card => card.idLabels?.length > 0).length;
+      const stillLabelled = copiedCards.filter(card => card.idLabels?.length > 0).length;
+      if (stillLabelled < labelled) {
+        warnings.push(
+          `${labelled - stillLabelled} of ${labelled} labelled cards lost their labels when copied to board ${targetBoardId}. Create matching labels on the target board and reapply them.`
+        );
+      }
+    }
+
+    return { list, cardCount: copiedCards.length, warnings };
+  }
+
+  /**
+   * Move a list and its cards to another board. Trello drops labels from the
+   * source board on the way, so with remapLabels each card gets the target
+   * board's labels of the same name. Members who are not on the target board
+   * are removed from cards, which is reported as a warning.
+   */
+  async moveListToBoard(params: {
+    listId: string;
+    targetBoardId: string;
+    position?: string | number;
+    remapLabels?: boolean;
+    concurrency?: number;
+  }): Promise<{
+    list: TrelloList;
+    sourceBoardId: string;
+    cardCount: number;
+    relabelled: number;
+    warnings: string[];
+  }> {
+    const sourceList = await this.getList(params.listId);
+    if (sourceList.idBoard === params.targetBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `List ${params.listId} is already on board ${params.targetBoardId}`
+      );
+    }
+    const [cards, sourceLabels, targetLabels] = await Promise.all([
+      this.getCardsByList(params.listId, 'name,idLabels,idMembers'),
+      params.remapLabels ? this.getBoardLabels(sourceList.idBoard) : Promise.resolve([]),
+      params.remapLabels ? this.getBoardLabels(params.targetBoardId) : Promise.resolve([]),
+    ]);
+
+    // Pair labels up before moving, so an ambiguous name fails with nothing changed
+    const namedTargets = targetLabels.filter(label => label.name);
+    const targetLabelIds = new Map<string, string>();
+    for (const { name } of sourceLabels) {
+      const candidates = name
+        ? filterByName(namedTargets, name, label => [label.name], this.nameMatching)
+        : [];
+      if (candidates.length > 0) {
+        const target = resolveByName(candidates, name, {
+          kind: 'Label',
+          names: label => [label.name],
+          mode: this.nameMatching,
+          idParam: 'remapLabels: false',
+        });
+        targetLabelIds.set(name, target.id);
+      }
+    }
+
+    const list = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.put<TrelloList>(
+        `/lists/${params.listId}/idBoard`,
+        {
+          value: params.targetBoardId,
+          ...(params.position !== undefined && { pos: params.position }),
+        }
+      );
+      return response.data;
+    });
+    for (const card of cards) {
+      this.cardBoards.set(card.id, params.targetBoardId);
+    }
+
+    const warnings: string[] = [];
+    const labelled = cards.filter(card => card.idLabels?.length > 0);
+    let relabelled = 0;
+    if (labelled.length > 0 && !params.remapLabels) {
+      warnings.push(
+        `${labelled.length} cards had labels from board ${sourceList.idBoard}, which may not carry over. Set remapLabels to reapply them by name.`
+      );
+    }
+    if (labelled.length > 0 && params.remapLabels) {
+      const sourceNames = new Map(sourceLabels.map(label => [label.id, label.name]));
+      const missing = new Set<string>();
+      const updates = labelled.map(card => {
+        const idLabels: string[] = [];
+        for (const labelId of card.idLabels) {
+          const name = sourceNames.get(labelId);
+          const targetId = name ? targetLabelIds.get(name) : undefined;
+          if (targetId) idLabels.push(targetId);
+          else missing.add(name || labelId);
+        }
+        return { card, idLabels };
       });
+      const settled = await mapWithConcurrency(
+        updates,
+        this.bulkConcurrency(params.concurrency),
+        ({ card, idLabels }) =>
+          this.handleRequest(async () => {
+            await this.axiosInstance.put(`/cards/${card.id}`, { idLabels: idLabels.join(',') });
+          })
+      );
+      relabelled = settled.filter(result => result.status === 'fulfilled').length;
+      if (missing.size > 0) {
+        warnings.push(
+          `No label with the same name on board ${params.targetBoardId} for: ${[...missing].join(', ')}`
+        );
+      }
+      if (relabelled < updates.length) {
+        warnings.push(`Could not relabel ${updates.length - relabelled} cards`);
+      }
+    }
+    const withMembers = cards.filter(card => card.idMembers?.length > 0).length;
+    if (withMembers > 0) {
+      warnings.push(
+        `${withMembers} cards had members assigned; members who are not on board ${params.targetBoardId} were removed from them.`
+      );
+    }
+
+    return {
+      list,
+      sourceBoardId: sourceList.idBoard,
+      cardCount: cards.length,
+      relabelled,
+      warnings,
+    };
+  }
+
+  async getList(listId: string): Promise<TrelloList> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/lists/${listId}`);
       return response.data;
     });
   }
@@ -510,17 +2079,207 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Subscribe or unsubscribe the authenticated member to a card and report the
+   * resulting state. Same write as watchCard, without returning the whole card.
+   */
+  async setCardSubscription(
+    cardId: string,
+    subscribed: boolean
+  ): Promise<{ cardId: string; subscribed: boolean }> {
+    const card = await this.watchCard(cardId, subscribed);
+    return { cardId, subscribed: card.subscribed ?? subscribed };
+  }
+
   async watchList(listId: string, subscribed: boolean): Promise<TrelloList> {
     return this.updateList(listId, { subscribed });
   }
 
-  async getMyCards(): Promise<TrelloCard[]> {
+  async getMyCards(fields?: string): Promise<TrelloCard[]> {
+    return this.handleRequest(async () => {
+      const response = fields
+        ? await this.axiosInstance.get('/members/me/cards', { params: { fields } })
+        : await this.axiosInstance.get('/members/me/cards');
+      return response.data;
+    });
+  }
+
+  /**
+   * Get the open cards on a board
+   */
+  async getBoardCards(
+    boardId?: string,
+    fields?: string,
+    filter?: 'open' | 'closed' | 'all'
+  ): Promise<TrelloCard[]> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
     return this.handleRequest(async () => {
-      const response = await this.axiosInstance.get('/members/me/cards');
+      const params = { ...(fields && { fields }), ...(filter && { filter }) };
+      const response = await this.axiosInstance.get(`/boards/${effectiveBoardId}/cards`, { params });
       return response.data;
     });
   }
 
+  /**
+   * Run Trello's search against one board's cards. partial makes the last word
+   * of the query match as a prefix.
+   */
+  async searchCardsInBoard(params: {
+    boardId?: string;
+    query: string;
+    limit?: number;
+    partial?: boolean;
+  }): Promise<
+    Array<{
+      id: string;
+      name: string;
+      url: string;
+      due: string | null;
+      list: { id: string; name: string } | null;
+    }>
+  > {
+    const effectiveBoardId = params.boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<{
+        cards?: Array<TrelloCard & { list?: { id: string; name: string } }>;
+      }>('/search', {
+        params: {
+          query: params.query,
+          idBoards: effectiveBoardId,
+          modelTypes: 'cards',
+          cards_limit: params.limit ?? 10,
+          card_fields: 'name,url,due,idList',
+          card_list: true,
+          partial: params.partial ?? false,
+        },
+      });
+      return (response.data.cards ?? []).map(card => ({
+        id: card.id,
+        name: card.name,
+        url: card.url,
+        due: card.due ?? null,
+        list: card.list ? { id: card.list.id, name: card.list.name } : null,
+      }));
+    });
+  }
+
+  /**
+   * Open cards on a board narrowed by any combination of filters, in one request.
+   * Fields the filters need are fetched alongside the requested ones. labelColor
+   * matches every board label of that color.
+   */
+  async findBoardCards(params: {
+    boardId?: string;
+    fields?: string;
+    hasMembers?: boolean;
+    hasDue?: boolean;
+    overdue?: boolean;
+    labelColor?: string;
+  }): Promise<TrelloCard[]> {
+    const needed = [
+      params.hasMembers !== undefined && 'idMembers',
+      (params.hasDue !== undefined || params.overdue !== undefined) && 'due',
+      params.overdue !== undefined && 'dueComplete',
+      params.labelColor && 'idLabels',
+    ].filter((field): field is string => typeof field === 'string');
+    const fields = params.fields
+      ? [...new Set([...params.fields.split(',').map(field => field.trim()), ...needed])].join(',')
+      : undefined;
+
+    let colorLabelIds: Set<string> | undefined;
+    if (params.labelColor) {
+      const color = params.labelColor.toLowerCase();
+      const labels = await this.getBoardLabels(params.boardId);
+      colorLabelIds = new Set(
+        labels.filter(label => label.color?.toLowerCase() === color).map(label => label.id)
+      );
+      if (colorLabelIds.size === 0) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          `No label with color "${params.labelColor}" on this board`
+        );
+      }
+    }
+
+    const now = Date.now();
+    const cards = await this.getBoardCards(params.boardId, fields, 'open');
+    return cards.filter(card => {
+      if (
+        params.hasMembers !== undefined &&
+        (card.idMembers ?? []).length > 0 !== params.hasMembers
+      ) {
+        return false;
+      }
+      if (params.hasDue !== undefined && Boolean(card.due) !== params.hasDue) {
+        return false;
+      }
+      if (params.overdue !== undefined) {
+        const isOverdue = Boolean(card.due) && !card.dueComplete && Date.parse(card.due!) < now;
+        if (isOverdue !== params.overdue) {
+          return false;
+        }
+      }
+      if (colorLabelIds && !(card.idLabels ?? []).some(id => colorLabelIds!.has(id))) {
+        return false;
+      }
+      return true;
+    });
+  }
+
+  /**
+   * Cards with an incomplete due date inside the next `withinHours`, soonest first.
+   * Scans one board when boardId is given, otherwise the cards assigned to the current user.
+   */
+  async getDueSoon(params: {
+    boardId?: string;
+    withinHours: number;
+    includeOverdue: boolean;
+  }): Promise<TrelloCard[]> {
+    const fields = 'name,due,dueComplete,idList,idBoard,url';
+    const cards = params.boardId
+      ? await this.getBoardCards(params.boardId, fields)
+      : await this.getMyCards(fields);
+
+    const now = Date.now();
+    const windowEnd = now + params.withinHours * 60 * 60 * 1000;
+    return cards
+      .filter(card => {
+        if (!card.due || card.dueComplete) return false;
+        const due = new Date(card.due).getTime();
+        if (due > windowEnd) return false;
+        return params.includeOverdue || due >= now;
+      })
+      .sort((a, b) => new Date(a.due!).getTime() - new Date(b.due!).getTime());
+  }
+
+  /**
+   * Open cards on a board sorted into overdue / due today / due this week / later /
+   * no due date buckets. timezone defaults to the server's.
+   */
+  async getDueReport(boardId: string | undefined, timezone?: string): Promise<DueReport> {
+    const [cards, lists] = await Promise.all([
+      this.getBoardCards(boardId, 'name,due,start,dueComplete,idList,url', 'open'),
+      this.getLists(boardId),
+    ]);
+    return buildDueReport(
+      cards,
+      new Map(lists.map(list => [list.id, list.name])),
+      timezone ?? Intl.DateTimeFormat().resolvedOptions().timeZone
+    );
+  }
+
   async attachImageToCard(
     boardId: string | undefined,
     cardId: string,
@@ -574,31 +2333,59 @@ export class TrelloClient {
     );
   }
 
+  /**
+   * Rename an attachment on a card; nothing else about the attachment changes
+   */
+  async renameAttachment(
+    boardId: string | undefined,
+    cardId: string,
+    attachmentId: string,
+    name: string
+  ): Promise<TrelloAttachment> {
+    return this.handleRequest(() =>
+      attachments.renameAttachment(this.axiosInstance, { cardId, attachmentId, name })
+    );
+  }
+
+  /**
+   * Get a card with its related resources. Each expansion defaults to included;
+   * pass false to leave that resource out of the response.
+   */
   async getCard(
     cardId: string,
-    includeMarkdown: boolean = false
+    includeMarkdown: boolean = false,
+    expand: {
+      members?: boolean;
+      checklists?: boolean;
+      attachments?: boolean;
+      customFields?: boolean;
+    } = {},
+    options: { noCache?: boolean } = {}
   ): Promise<EnhancedTrelloCard | string> {
+    const { members = true, checklists = true, attachments = true, customFields = true } = expand;
     return this.handleRequest(async () => {
-      const response = await this.axiosInstance.get(`/cards/${cardId}`, {
-        params: {
-          attachments: true,
-          checklists: 'all',
-          checkItemStates: true,
-          members: true,
-          membersVoted: true,
-          labels: true,
-          actions: 'commentCard',
-          actions_limit: 100,
-          fields: 'all',
-          customFieldItems: true,
-          list: true,
-          board: true,
-          stickers: true,
-          pluginData: true,
+      const cardData = await this.getWithEtag<EnhancedTrelloCard>(
+        `/cards/${cardId}`,
+        {
+          params: {
+            attachments,
+            checklists: checklists ? 'all' : 'none',
+            checkItemStates: checklists,
+            members,
+            membersVoted: members,
+            labels: true,
+            actions: 'commentCard',
+            actions_limit: 100,
+            fields: 'all',
+            customFieldItems: customFields,
+            list: true,
+            board: true,
+            stickers: true,
+            pluginData: true,
+          },
         },
-      });
-
-      const cardData: EnhancedTrelloCard = response.data;
+        options.noCache
+      );
 
       if (includeMarkdown) {
         return this.formatCardAsMarkdown(cardData);
@@ -608,8 +2395,130 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Human-readable list and board names for a card. Names embedded in the card
+   * (getCard fetches them) are used as is; otherwise each list and board is looked
+   * up once per session.
+   */
+  async getCardLocationNames(card: {
+    idList: string;
+    idBoard: string;
+    list?: { name?: string };
+    board?: { name?: string };
+  }): Promise<{ listName: string; boardName: string }> {
+    const lookup = (
+      cache: Map<string, Promise<string>>,
+      id: string,
+      fetch: (id: string) => Promise<{ name: string }>
+    ) => {
+      let name = cache.get(id);
+      if (!name) {
+        name = fetch(id).then(entity => entity.name);
+        cache.set(id, name);
+        name.catch(() => cache.delete(id));
+      }
+      return name;
+    };
+    const [listName, boardName] = await Promise.all([
+      card.list?.name ?? lookup(this.listNames, card.idList, id => this.getList(id)),
+      card.board?.name ?? lookup(this.boardNames, card.idBoard, id => this.getBoardById(id)),
+    ]);
+    return { listName, boardName };
+  }
+
+  /**
+   * A card's name and description markdown, and nothing else. A card without a
+   * description gets an empty string.
+   */
+  async getCardDescription(cardId: string): Promise<{ id: string; name: string; desc: string }> {
+    const card = await this.getCardById(cardId, 'name,desc');
+    return { id: card.id, name: card.name, desc: card.desc ?? '' };
+  }
+
+  /**
+   * A card's labels as compact "color:name" strings, and nothing else
+   */
+  async getCardLabelsSummary(cardId: string): Promise<string[]> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<{ labels?: TrelloLabelDetails[] }>(
+        `/cards/${cardId}`,
+        { params: { fields: 'labels' } }
+      );
+      return (response.data.labels ?? []).map(
+        label => formatLabel(label, 'color:name') as string
+      );
+    });
+  }
+
+  /**
+   * The members assigned to a card as { id, username, fullName, avatarUrl }.
+   * Member lookups are cached for the session, so only new assignees cost a request.
+   * Members that could not be looked up are listed under failures.
+   */
+  async getCardMemberDetails(cardId: string): Promise<{
+    members: TrelloMember[];
+    failures: Array<{ memberId: string; error: string }>;
+  }> {
+    const { idMembers } = await this.getCardById(cardId, 'idMembers');
+    const memberIds = idMembers ?? [];
+    const settled = await mapWithConcurrency(memberIds, TrelloClient.BULK_CONCURRENCY, id =>
+      this.getMemberDetails(id)
+    );
+    const { fulfilled, rejected } = partitionSettled(memberIds, settled);
+    return {
+      members: fulfilled.map(({ value }) => value),
+      failures: rejected.map(({ item, error }) => ({ memberId: item, error })),
+    };
+  }
+
+  private getMemberDetails(memberId: string): Promise<TrelloMember> {
+    let member = this.memberDetails.get(memberId);
+    if (!member) {
+      member = this.handleRequest(async () => {
+        const response = await this.axiosInstance.get(`/members/${memberId}`, {
+          params: { fields: 'id,username,fullName,avatarUrl' },
+        });
+        const { id, username, fullName, avatarUrl } = response.data;
+        return { id, username, fullName, avatarUrl: avatarUrl ?? null };
+      });
+      this.memberDetails.set(memberId, member);
+      member.catch(() => this.memberDetails.delete(memberId));
+    }
+    return member;
+  }
+
+  /**
+   * Resolve a pasted card URL or short link code to the full card
+   */
+  async getCardByShortLink(
+    urlOrShortLink: string,
+    includeMarkdown: boolean = false
+  ): Promise<EnhancedTrelloCard | string> {
+    return this.getCard(parseCardShortLink(urlOrShortLink), includeMarkdown);
+  }
+
+  /**
+   * Resolve a card by its board-local number (the #42 shown in Trello) with one
+   * scan of the board's cards, archived ones included
+   */
+  async getCardByShortId(
+    boardId: string | undefined,
+    shortId: number,
+    includeMarkdown: boolean = false
+  ): Promise<EnhancedTrelloCard | string> {
+    const cards = await this.getBoardCards(boardId, 'idShort', 'all');
+    const match = (cards as Array<TrelloCard & { idShort?: number }>).find(
+      card => card.idShort === shortId
+    );
+    if (!match) {
+      throw new McpError(ErrorCode.InvalidParams, `Card #${shortId} not found on this board`);
+    }
+    return this.getCard(match.id, includeMarkdown);
+  }
+
   // Add Comment on Card
   async addCommentToCard(cardId: string, text: string): Promise<TrelloComment> {
+    assertCommentLength(text);
     return this.handleRequest(async () => {
       const response = await this.axiosInstance.post(
         `cards/${cardId}/actions/comments?text=${encodeURIComponent(text)}`
@@ -618,8 +2527,110 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Add a comment that @mentions the given members, resolved against the card's board members
+   */
+  async addCommentWithMentions(
+    cardId: string,
+    text: string,
+    mentionMemberIds: string[]
+  ): Promise<{ comment: TrelloComment; skippedMentions: Array<{ memberId: string; reason: string }> }> {
+    const members = await this.getBoardMembers(await this.resolveCardBoardId(cardId));
+    const rendered = renderMentions(text, members, mentionMemberIds);
+    const comment = await this.addCommentToCard(cardId, rendered.text);
+    return { comment, skippedMentions: rendered.skipped };
+  }
+
+  /**
+   * Post a comment that may be longer than Trello allows as a numbered sequence of
+   * comments, in order. Mentions are rendered once, so only the first part carries them.
+   * Posting stops at the first part that fails; the parts already posted are returned
+   * alongside the failure so the caller can resume or delete them.
+   */
+  async addCommentInParts(
+    cardId: string,
+    text: string,
+    mentionMemberIds: string[] = []
+  ): Promise<{
+    commentIds: string[];
+    skippedMentions: Array<{ memberId: string; reason: string }>;
+    failure?: { part: number; totalParts: number; error: string };
+  }> {
+    let skippedMentions: Array<{ memberId: string; reason: string }> = [];
+    if (mentionMemberIds.length > 0) {
+      const members = await this.getBoardMembers(await this.resolveCardBoardId(cardId));
+      const rendered = renderMentions(text, members, mentionMemberIds);
+      text = rendered.text;
+      skippedMentions = rendered.skipped;
+    }
+    const parts = splitComment(text);
+    const commentIds: string[] = [];
+    for (const [index, part] of parts.entries()) {
+      try {
+        commentIds.push((await this.addCommentToCard(cardId, part)).id);
+      } catch (error) {
+        return {
+          commentIds,
+          skippedMentions,
+          failure: {
+            part: index + 1,
+            totalParts: parts.length,
+            error: error instanceof Error ? error.message : 'Unknown error',
+          },
+        };
+      }
+    }
+    return { commentIds, skippedMentions };
+  }
+
+  /**
+   * Post the same comment to many cards with bounded concurrency. Mentions are
+   * resolved once against boardId (or the default board, or else the first card's
+   * board) and applied to every card.
+   */
+  async commentOnCards(params: {
+    cardIds: string[];
+    text: string;
+    boardId?: string;
+    mentionMemberIds?: string[];
+    concurrency?: number;
+  }): Promise<{
+    results: Array<{ cardId: string; success: boolean; commentId?: string; error?: string }>;
+    skippedMentions: Array<{ memberId: string; reason: string }>;
+  }> {
+    let text = params.text;
+    let skippedMentions: Array<{ memberId: string; reason: string }> = [];
+    if (params.mentionMemberIds && params.mentionMemberIds.length > 0) {
+      const members = await this.getBoardMembers(
+        params.boardId ||
+          this.effectiveDefaultBoardId ||
+          (await this.resolveCardBoardId(params.cardIds[0]))
+      );
+      const rendered = renderMentions(text, members, params.mentionMemberIds);
+      text = rendered.text;
+      skippedMentions = rendered.skipped;
+    }
+    assertCommentLength(text);
+    const settled = await mapWithConcurrency(
+      params.cardIds,
+      this.bulkConcurrency(params.concurrency),
+      cardId => this.addCommentToCard(cardId, text)
+    );
+    const { fulfilled, rejected } = partitionSettled(params.cardIds, settled);
+    const results: Array<{ cardId: string; success: boolean; commentId?: string; error?: string }> =
+      new Array(settled.length);
+    for (const { item, index, value } of fulfilled) {
+      results[index] = { cardId: item, success: true, commentId: value.id };
+    }
+    for (const { item, index, error } of rejected) {
+      results[index] = { cardId: item, success: false, error };
+    }
+    return { results, skippedMentions };
+  }
+
   // Update Comment
   async updateCommentOnCard(commentId: string, text: string): Promise<boolean> {
+    assertCommentLength(text);
     return this.handleRequest(async () => {
       const response = await this.axiosInstance.put(
         `/actions/${commentId}?text=${encodeURIComponent(text)}`
@@ -631,6 +2642,96 @@ export class TrelloClient {
     });
   }
 
+  // Get a single action (e.g. a comment) by ID
+  async getAction(actionId: string): Promise<TrelloAction> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/actions/${actionId}`);
+      return response.data;
+    });
+  }
+
+  /**
+   * Normalize an emoji short name (":thumbsup:" -> "thumbsup") and check it against
+   * Trello's emoji list, which is fetched once per session.
+   */
+  private async resolveEmoji(emoji: string): Promise<string> {
+    if (!this.emojiShortNames) {
+      this.emojiShortNames = this.handleRequest(async () => {
+        const response = await this.axiosInstance.get('/emoji', {
+          params: { spritesheets: false },
+        });
+        const names = new Set<string>();
+        for (const group of Object.values(response.data ?? {})) {
+          if (!Array.isArray(group)) continue;
+          for (const entry of group as Array<{ shortName?: string; shortNames?: string[] }>) {
+            if (entry.shortName) names.add(entry.shortName);
+            entry.shortNames?.forEach(name => names.add(name));
+          }
+        }
+        return names;
+      });
+      this.emojiShortNames.catch(() => {
+        this.emojiShortNames = undefined;
+      });
+    }
+    const shortName = emoji.trim().replace(/^:|:$/g, '').toLowerCase();
+    if (!(await this.emojiShortNames).has(shortName)) {
+      throw new McpError(ErrorCode.InvalidParams, `Unknown emoji "${emoji}"`);
+    }
+    return shortName;
+  }
+
+  async getCommentReactionSummary(commentId: string): Promise<TrelloReactionSummary[]> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/actions/${commentId}/reactionsSummary`);
+      return (
+        response.data as Array<{ count: number; emoji: { shortName: string; native?: string } }>
+      ).map(entry => ({
+        emoji: entry.emoji.shortName,
+        native: entry.emoji.native ?? null,
+        count: entry.count,
+      }));
+    });
+  }
+
+  /**
+   * React to a comment as the authenticated member and return the updated tally
+   */
+  async addCommentReaction(commentId: string, emoji: string): Promise<TrelloReactionSummary[]> {
+    const shortName = await this.resolveEmoji(emoji);
+    await this.handleRequest(() =>
+      this.axiosInstance.post(`/actions/${commentId}/reactions`, { shortName })
+    );
+    return this.getCommentReactionSummary(commentId);
+  }
+
+  /**
+   * Remove the authenticated member's reaction with this emoji and return the updated tally
+   */
+  async removeCommentReaction(commentId: string, emoji: string): Promise<TrelloReactionSummary[]> {
+    const shortName = await this.resolveEmoji(emoji);
+    const me = await this.whoami();
+    const reactions = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/actions/${commentId}/reactions`, {
+        params: { member: false, emoji: true },
+      });
+      return response.data as Array<{ id: string; idMember: string; emoji: { shortName: string } }>;
+    });
+    const mine = reactions.find(
+      reaction => reaction.idMember === me.id && reaction.emoji.shortName === shortName
+    );
+    if (!mine) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `You have not reacted with :${shortName}: to this comment`
+      );
+    }
+    await this.handleRequest(() =>
+      this.axiosInstance.delete(`/actions/${commentId}/reactions/${mine.id}`)
+    );
+    return this.getCommentReactionSummary(commentId);
+  }
+
   // Delete Comment
   async deleteCommentFromCard(commentId: string): Promise<boolean> {
     return this.handleRequest(async () => {
@@ -640,12 +2741,17 @@ export class TrelloClient {
   }
 
   // Get Card Comments
-  async getCardComments(cardId: string, limit: number = 100): Promise<TrelloComment[]> {
+  async getCardComments(
+    cardId: string,
+    limit: number = 100,
+    before?: string
+  ): Promise<TrelloComment[]> {
     return this.handleRequest(async () => {
       const response = await this.axiosInstance.get(`/cards/${cardId}/actions`, {
         params: {
           filter: 'commentCard',
           limit: limit,
+          ...(before && { before }),
         },
       });
       return response.data;
@@ -677,13 +2783,11 @@ export class TrelloClient {
 
     const allCheckItems: CheckListItem[] = [];
 
-    for (const checklist of checklists) {
-      if (checklist.name.toLowerCase() === name.toLowerCase()) {
-        const convertedItems = checklist.checkItems.map(item =>
-          this.convertToCheckListItem(item, checklist.id)
-        );
-        allCheckItems.push(...convertedItems);
-      }
+    for (const checklist of filterByName(checklists, name, cl => [cl.name], this.nameMatching)) {
+      const convertedItems = checklist.checkItems.map(item =>
+        this.convertToCheckListItem(item, checklist.id)
+      );
+      allCheckItems.push(...convertedItems);
     }
 
     return allCheckItems;
@@ -716,13 +2820,13 @@ export class TrelloClient {
       checklists = checklistsResponse.data;
     }
 
-    const targetChecklist = checklists.find(
-      checklist => checklist.name.toLowerCase() === checkListName.toLowerCase()
-    );
-
-    if (!targetChecklist) {
-      throw new McpError(ErrorCode.InvalidParams, `Checklist "${checkListName}" not found${cardId ? ' on card' : ' on board'}`);
-    }
+    const targetChecklist = resolveByName(checklists, checkListName, {
+      kind: 'Checklist',
+      names: checklist => [checklist.name],
+      mode: this.nameMatching,
+      idParam: cardId ? 'an exact checklist name' : 'cardId',
+      notFoundHint: `No checklist with that name on the ${cardId ? 'card' : 'board'}.`,
+    });
 
     // Add the check item to the checklist
     const itemResponse = await this.axiosInstance.post<TrelloCheckItem>(
@@ -735,11 +2839,17 @@ export class TrelloClient {
     return this.convertToCheckListItem(itemResponse.data, targetChecklist.id);
   }
 
+  /**
+   * Find checklist items whose text contains description, or matches it as a
+   * regular expression when regex is set. Each match names its checklist and card.
+   */
   async findChecklistItemsByDescription(
     description: string,
     cardId?: string,
-    boardId?: string
-  ): Promise<CheckListItem[]> {
+    boardId?: string,
+    options: { regex?: boolean } = {}
+  ): Promise<CheckListItemMatch[]> {
+    const matches = buildCheckItemMatcher(description, options.regex ?? false);
     let checklists: TrelloChecklist[];
 
     if (cardId) {
@@ -761,13 +2871,16 @@ export class TrelloClient {
       checklists = response.data;
     }
 
-    const matchingItems: CheckListItem[] = [];
-    const searchTerm = description.toLowerCase();
+    const matchingItems: CheckListItemMatch[] = [];
 
     for (const checklist of checklists) {
       for (const checkItem of checklist.checkItems) {
-        if (checkItem.name.toLowerCase().includes(searchTerm)) {
-          matchingItems.push(this.convertToCheckListItem(checkItem, checklist.id));
+        if (matches(checkItem.name)) {
+          matchingItems.push({
+            ...this.convertToCheckListItem(checkItem, checklist.id),
+            checklistName: checklist.name,
+            cardId: checklist.idCard,
+          });
         }
       }
     }
@@ -787,37 +2900,60 @@ export class TrelloClient {
     return response.data;
   }
 
+  /**
+   * Get every checklist on a card with its items and completion percentage.
+   */
+  async getCardChecklists(cardId: string, fields?: string): Promise<CheckList[]> {
+    return this.handleRequest(() => getCardChecklists(this.axiosInstance, cardId, { fields }));
+  }
+
   async getChecklistByName(name: string, cardId?: string, boardId?: string): Promise<CheckList | null> {
-    let checklists: TrelloChecklist[];
+    const checklists = await this.loadChecklistsForLookup(cardId, boardId);
+
+    const matches = filterByName(checklists, name, checklist => [checklist.name], this.nameMatching);
+    if (matches.length === 0) {
+      return null;
+    }
+    // Several matches raise the ambiguity error rather than picking one
+    const targetChecklist = resolveByName(matches, name, {
+      kind: 'Checklist',
+      names: checklist => [checklist.name],
+      mode: this.nameMatching,
+      idParam: cardId ? 'an exact checklist name' : 'cardId',
+    });
+    return this.convertToCheckList(targetChecklist);
+  }
+
+  /**
+   * All checklists whose name contains the search text (case-insensitive)
+   */
+  async findChecklistsByName(name: string, cardId?: string, boardId?: string): Promise<CheckList[]> {
+    const checklists = await this.loadChecklistsForLookup(cardId, boardId);
+    const needle = name.trim().toLowerCase();
+    return checklists
+      .filter(checklist => checklist.name.toLowerCase().includes(needle))
+      .map(checklist => this.convertToCheckList(checklist));
+  }
 
+  private async loadChecklistsForLookup(cardId?: string, boardId?: string): Promise<TrelloChecklist[]> {
     if (cardId) {
       // Get checklists from the specific card
       const cardResponse = await this.axiosInstance.get<EnhancedTrelloCard>(`/cards/${cardId}`, {
         params: { checklists: 'all' }
       });
-      checklists = cardResponse.data.checklists || [];
-    } else {
-      // Fall back to board-level search
-      const effectiveBoardId = boardId || this.activeConfig.boardId;
-      if (!effectiveBoardId) {
-        throw new McpError(ErrorCode.InvalidParams, 'No board ID or card ID provided and no active board set');
-      }
-
-      const response = await this.axiosInstance.get<TrelloChecklist[]>(
-        `/boards/${effectiveBoardId}/checklists`
-      );
-      checklists = response.data;
+      return cardResponse.data.checklists || [];
     }
 
-    const targetChecklist = checklists.find(
-      checklist => checklist.name.toLowerCase() === name.toLowerCase()
-    );
-
-    if (targetChecklist) {
-      return this.convertToCheckList(targetChecklist);
+    // Fall back to board-level search
+    const effectiveBoardId = boardId || this.activeConfig.boardId;
+    if (!effectiveBoardId) {
+      throw new McpError(ErrorCode.InvalidParams, 'No board ID or card ID provided and no active board set');
     }
 
-    return null;
+    const response = await this.axiosInstance.get<TrelloChecklist[]>(
+      `/boards/${effectiveBoardId}/checklists`
+    );
+    return response.data;
   }
 
   /**
@@ -850,6 +2986,18 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Get a single checklist item on a card.
+   */
+  async getChecklistItem(cardId: string, checkItemId: string): Promise<TrelloCheckItem> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<TrelloCheckItem>(
+        `/cards/${cardId}/checkItem/${checkItemId}`
+      );
+      return response.data;
+    });
+  }
+
   /**
    * Delete a checklist item from a card.
    */
@@ -860,6 +3008,123 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Reposition a checklist item. The item can be addressed by ID or by its text,
+   * optionally scoped to a named checklist on the card.
+   */
+  async updateChecklistItemPosition(params: {
+    cardId: string;
+    position: number | 'top' | 'bottom';
+    checkItemId?: string;
+    checklistName?: string;
+    itemText?: string;
+  }): Promise<TrelloCheckItem> {
+    if (!params.checkItemId && !params.itemText) {
+      throw new McpError(ErrorCode.InvalidParams, 'Either checkItemId or itemText must be provided');
+    }
+    const checkItemId =
+      params.checkItemId && !params.checklistName
+        ? params.checkItemId
+        : (await this.resolveCardCheckItem(params.cardId, params)).checkItem.id;
+
+    return this.updateChecklistItem(params.cardId, checkItemId, { pos: params.position });
+  }
+
+  /**
+   * Set a checklist item's due date and/or assignee. These are advanced checklist
+   * fields, so a permission error from Trello is reported as a plan limitation.
+   */
+  async setChecklistItemDue(params: {
+    cardId: string;
+    checkItemId?: string;
+    itemText?: string;
+    checklistName?: string;
+    due?: string | null;
+    memberId?: string | null;
+  }): Promise<TrelloCheckItem> {
+    if (!params.checkItemId && !params.itemText) {
+      throw new McpError(ErrorCode.InvalidParams, 'Either checkItemId or itemText must be provided');
+    }
+    if (params.due === undefined && params.memberId === undefined) {
+      throw new McpError(ErrorCode.InvalidParams, 'Either due or memberId must be provided');
+    }
+    const checkItemId =
+      params.checkItemId && !params.checklistName
+        ? params.checkItemId
+        : (await this.resolveCardCheckItem(params.cardId, params)).checkItem.id;
+
+    const payload: TrelloCheckItemUpdate = {};
+    if (params.due !== undefined) payload.due = params.due;
+    if (params.memberId !== undefined) payload.idMember = params.memberId;
+
+    return this.handleRequest(async () => {
+      try {
+        const response = await this.axiosInstance.put<TrelloCheckItem>(
+          `/cards/${params.cardId}/checkItem/${checkItemId}`,
+          payload
+        );
+        return response.data;
+      } catch (error) {
+        const status = axios.isAxiosError(error) ? error.response?.status : undefined;
+        if (status === 401 || status === 403) {
+          throw new McpError(
+            ErrorCode.InvalidRequest,
+            'Checklist item due dates and assignees are advanced checklist features that require a Trello Premium (or higher) workspace'
+          );
+        }
+        throw error;
+      }
+    });
+  }
+
+  /**
+   * Promote a checklist item to a card of its own. Trello removes the item from
+   * its checklist as part of the conversion.
+   */
+  async convertChecklistItemToCard(params: {
+    cardId: string;
+    checkItemId?: string;
+    itemText?: string;
+    checklistId?: string;
+    checklistName?: string;
+  }): Promise<TrelloCard> {
+    if (!params.checkItemId && !params.itemText) {
+      throw new McpError(ErrorCode.InvalidParams, 'Either checkItemId or itemText must be provided');
+    }
+    let checklistId = params.checklistId;
+    let checkItemId = params.checkItemId;
+    if (!checklistId || !checkItemId) {
+      const resolved = await this.resolveCardCheckItem(params.cardId, params);
+      checklistId = resolved.checklist.id;
+      checkItemId = resolved.checkItem.id;
+    }
+
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.post(
+        `/cards/${params.cardId}/checklist/${checklistId}/checkItem/${checkItemId}/convertToCard`
+      );
+      return response.data;
+    });
+  }
+
+  private async resolveCardCheckItem(
+    cardId: string,
+    query: {
+      checkItemId?: string;
+      checklistId?: string;
+      checklistName?: string;
+      itemText?: string;
+    }
+  ): Promise<{ checklist: TrelloChecklist; checkItem: TrelloCheckItem }> {
+    const checklists = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.get<TrelloChecklist[]>(
+        `/cards/${cardId}/checklists`
+      );
+      return response.data;
+    });
+    return resolveCheckItem(checklists, query, this.nameMatching);
+  }
+
   private formatCardAsMarkdown(card: EnhancedTrelloCard): string {
     let markdown = '';
 
@@ -1068,6 +3333,49 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Resolve a board member by ID, username (with or without @), or full name
+   * (case-insensitive)
+   */
+  async findBoardMember(boardId: string | undefined, member: string): Promise<TrelloMember> {
+    const members = await this.getBoardMembers(boardId);
+    const needle = member.trim().replace(/^@/, '').toLowerCase();
+    const byId = members.find(candidate => candidate.id === member.trim());
+    if (byId) return byId;
+    const byUsername = members.find(candidate => candidate.username.toLowerCase() === needle);
+    if (byUsername) return byUsername;
+    const byName = members.filter(candidate => candidate.fullName?.toLowerCase() === needle);
+    if (byName.length === 0) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Member "${member}" not found on this board. Use get_board_members to see members.`
+      );
+    }
+    if (byName.length > 1) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Member name "${member}" is ambiguous: ${byName.map(candidate => `@${candidate.username} (${candidate.id})`).join(', ')}. Pass a username or member ID instead.`
+      );
+    }
+    return byName[0];
+  }
+
+  /**
+   * Open cards on a board assigned to a member, filtered client-side from one
+   * board card fetch
+   */
+  async findCardsByMember(
+    boardId: string | undefined,
+    member: string
+  ): Promise<{ member: TrelloMember; cards: TrelloCard[] }> {
+    const resolved = await this.findBoardMember(boardId, member);
+    const cards = await this.getBoardCards(boardId, 'name,idList,idMembers,url');
+    return {
+      member: resolved,
+      cards: cards.filter(card => card.idMembers?.includes(resolved.id)),
+    };
+  }
+
   async assignMemberToCard(
     cardId: string,
     memberId: string
@@ -1090,8 +3398,193 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Make a card's members exactly `memberIds`, issuing only the adds and removes needed
+   */
+  async setCardMembers(
+    cardId: string,
+    memberIds: string[]
+  ): Promise<{ added: string[]; removed: string[]; unchanged: string[] }> {
+    const card = await this.getCardById(cardId, 'idMembers');
+    const current = new Set(card.idMembers ?? []);
+    const desired = new Set(memberIds);
+
+    const added = [...desired].filter(id => !current.has(id));
+    const removed = [...current].filter(id => !desired.has(id));
+    const unchanged = [...current].filter(id => desired.has(id));
+
+    for (const memberId of added) {
+      await this.assignMemberToCard(cardId, memberId);
+    }
+    for (const memberId of removed) {
+      await this.removeMemberFromCard(cardId, memberId);
+    }
+    return { added, removed, unchanged };
+  }
+
+  /**
+   * Assign several members to a card, skipping those already assigned so a retried
+   * call does not repeat work. Each member succeeds or fails on its own.
+   */
+  async assignMembersToCard(
+    cardId: string,
+    memberIds: string[]
+  ): Promise<{
+    results: Array<{
+      memberId: string;
+      success: boolean;
+      status?: 'added' | 'skipped';
+      reason?: string;
+      error?: string;
+    }>;
+  }> {
+    const card = await this.getCardById(cardId, 'idMembers');
+    const current = new Set(card.idMembers ?? []);
+    const results = [];
+    for (const memberId of new Set(memberIds)) {
+      if (current.has(memberId)) {
+        results.push({
+          memberId,
+          success: true,
+          status: 'skipped' as const,
+          reason: 'already present',
+        });
+        continue;
+      }
+      try {
+        await this.assignMemberToCard(cardId, memberId);
+        results.push({ memberId, success: true, status: 'added' as const });
+      } catch (error) {
+        results.push({
+          memberId,
+          success: false,
+          error: error instanceof Error ? error.message : 'Unknown error',
+        });
+      }
+    }
+    return { results };
+  }
+
+  /**
+   * Unassign everyone from a card. A card with no members is left as is.
+   */
+  async removeAllMembersFromCard(
+    boardId: string | undefined,
+    cardId: string
+  ): Promise<{ removedCount: number; removed: string[] }> {
+    const { removed } = await this.setCardMembers(cardId, []);
+    return { removedCount: removed.length, removed };
+  }
+
   // Label management methods
-  async getBoardLabels(boardId?: string): Promise<TrelloLabelDetails[]> {
+  async getBoardLabels(
+    boardId?: string,
+    options: { noCache?: boolean } = {}
+  ): Promise<TrelloLabelDetails[]> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    return this.handleRequest(() =>
+      this.getWithEtag<TrelloLabelDetails[]>(
+        `/boards/${effectiveBoardId}/labels`,
+        undefined,
+        options.noCache
+      )
+    );
+  }
+
+  /**
+   * How many cards use each board label, most used first. Only open cards are
+   * counted unless includeClosed is set.
+   */
+  async getBoardLabelsUsage(
+    boardId?: string,
+    includeClosed: boolean = false
+  ): Promise<
+    Array<{ labelId: string; name: string; color: string; cardCount: number; unused: boolean }>
+  > {
+    const [labels, cards] = await Promise.all([
+      this.getBoardLabels(boardId),
+      this.getBoardCards(boardId, 'idLabels', includeClosed ? 'all' : 'open'),
+    ]);
+    const counts = new Map<string, number>();
+    for (const card of cards) {
+      for (const labelId of card.idLabels ?? []) {
+        counts.set(labelId, (counts.get(labelId) ?? 0) + 1);
+      }
+    }
+    return labels
+      .map(label => {
+        const cardCount = counts.get(label.id) ?? 0;
+        return {
+          labelId: label.id,
+          name: label.name,
+          color: label.color,
+          cardCount,
+          unused: cardCount === 0,
+        };
+      })
+      .sort((a, b) => b.cardCount - a.cardCount || a.name.localeCompare(b.name));
+  }
+
+  /**
+   * Open cards per list broken down by label, from a single card fetch. Lists
+   * come back in board order; labels are keyed by name, or color when unnamed.
+   */
+  async getCardCountByLabel(boardId?: string): Promise<{
+    lists: Array<{
+      listId: string;
+      name: string;
+      total: number;
+      unlabeled: number;
+      labels: Record<string, number>;
+    }>;
+    totals: Record<string, number>;
+  }> {
+    const [lists, labels, cards] = await Promise.all([
+      this.getLists(boardId),
+      this.getBoardLabels(boardId),
+      this.getBoardCards(boardId, 'idLabels,idList', 'open'),
+    ]);
+    const labelKeys = new Map(
+      labels.map(label => [label.id, label.name || label.color || label.id])
+    );
+    const rows = new Map(
+      lists.map(list => [
+        list.id,
+        {
+          listId: list.id,
+          name: list.name,
+          total: 0,
+          unlabeled: 0,
+          labels: {} as Record<string, number>,
+        },
+      ])
+    );
+    const totals: Record<string, number> = {};
+    for (const card of cards) {
+      const row = rows.get(card.idList);
+      if (!row) continue;
+      row.total++;
+      const keys = new Set((card.idLabels ?? []).map(id => labelKeys.get(id) ?? id));
+      if (keys.size === 0) row.unlabeled++;
+      for (const key of keys) {
+        row.labels[key] = (row.labels[key] ?? 0) + 1;
+        totals[key] = (totals[key] ?? 0) + 1;
+      }
+    }
+    return { lists: [...rows.values()], totals };
+  }
+
+  async createLabel(
+    boardId: string | undefined,
+    name: string,
+    color?: string
+  ): Promise<TrelloLabelDetails> {
     const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
     if (!effectiveBoardId) {
       throw new McpError(
@@ -1100,69 +3593,446 @@ export class TrelloClient {
       );
     }
     return this.handleRequest(async () => {
-      const response = await this.axiosInstance.get(`/boards/${effectiveBoardId}/labels`);
+      const response = await this.axiosInstance.post(`/boards/${effectiveBoardId}/labels`, {
+        name,
+        color,
+      });
+      return response.data;
+    });
+  }
+
+  async getLabel(labelId: string): Promise<TrelloLabelDetails> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/labels/${labelId}`);
+      return response.data;
+    });
+  }
+
+  async updateLabel(
+    labelId: string,
+    name?: string,
+    color?: string
+  ): Promise<TrelloLabelDetails> {
+    return this.handleRequest(async () => {
+      const updateData: { name?: string; color?: string } = {};
+      if (name !== undefined) updateData.name = name;
+      if (color !== undefined) updateData.color = color;
+
+      const response = await this.axiosInstance.put(`/labels/${labelId}`, updateData);
+      return response.data;
+    });
+  }
+
+  async deleteLabel(labelId: string): Promise<boolean> {
+    return this.handleRequest(async () => {
+      await this.axiosInstance.delete(`/labels/${labelId}`);
+      return true;
+    });
+  }
+
+  private static readonly LABEL_BASE_COLORS = [
+    'green',
+    'yellow',
+    'orange',
+    'red',
+    'purple',
+    'blue',
+    'sky',
+    'lime',
+    'pink',
+    'black',
+  ];
+  /** Trello's label palette: each base color plus its _dark and _light shades */
+  static readonly LABEL_COLORS = TrelloClient.LABEL_BASE_COLORS.flatMap(color => [
+    color,
+    `${color}_dark`,
+    `${color}_light`,
+  ]);
+
+  /**
+   * Change only a label's color. The label is given by ID or by its current name
+   * on the board (case-insensitive).
+   */
+  async setLabelColor(params: {
+    label: string;
+    color: string;
+    boardId?: string;
+  }): Promise<TrelloLabelDetails> {
+    const color = params.color.trim().toLowerCase();
+    if (!TrelloClient.LABEL_COLORS.includes(color)) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Invalid label color "${params.color}". Allowed colors: ${TrelloClient.LABEL_COLORS.join(', ')}`
+      );
+    }
+    let labelId = params.label.trim();
+    if (!isTrelloId(labelId)) {
+      const matches = filterByName(
+        await this.getBoardLabels(params.boardId),
+        labelId,
+        label => [label.name],
+        this.nameMatching
+      );
+      if (matches.length === 0) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          `No label named "${params.label}" on this board`
+        );
+      }
+      if (matches.length > 1) {
+        throw new McpError(
+          ErrorCode.InvalidParams,
+          `Several labels are named "${params.label}": ${matches.map(label => `${label.color ?? 'no color'} (${label.id})`).join(', ')}. Pass the label ID instead.`
+        );
+      }
+      labelId = matches[0].id;
+    }
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.put(`/labels/${labelId}`, { color });
+      return response.data;
+    });
+  }
+
+  async searchLabels(boardId: string | undefined, query: string): Promise<TrelloLabelDetails[]> {
+    const labels = await this.getBoardLabels(boardId);
+    const normalizedQuery = query.trim().toLowerCase();
+    if (!normalizedQuery) {
+      return [];
+    }
+
+    return labels.filter(label => {
+      const normalizedName = label.name.toLowerCase();
+      const normalizedColor = label.color?.toLowerCase() ?? '';
+
+      return (
+        normalizedName.includes(normalizedQuery) || normalizedColor.includes(normalizedQuery)
+      );
+    });
+  }
+
+  async addLabelToCard(cardId: string, labelId: string): Promise<string[]> {
+    return this.handleRequest(async () => {
+      const response = await this.axiosInstance.post(`/cards/${cardId}/idLabels`, {
+        value: labelId,
+      });
       return response.data;
     });
   }
 
-  async createLabel(
-    boardId: string | undefined,
-    name: string,
-    color?: string
-  ): Promise<TrelloLabelDetails> {
-    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
-    if (!effectiveBoardId) {
+  /**
+   * Find the single board label with the given color
+   */
+  async findLabelByColor(boardId: string | undefined, color: string): Promise<TrelloLabelDetails> {
+    const labels = await this.getBoardLabels(boardId);
+    const matches = labels.filter(label => label.color?.toLowerCase() === color.toLowerCase());
+    if (matches.length === 0) {
+      throw new McpError(ErrorCode.InvalidParams, `No label with color "${color}" on this board`);
+    }
+    if (matches.length > 1) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Several labels use color "${color}": ${matches.map(label => `"${label.name}" (${label.id})`).join(', ')}. Pass labelId instead.`
+      );
+    }
+    return matches[0];
+  }
+
+  /** Default and maximum number of requests a bulk operation keeps in flight */
+  static readonly BULK_CONCURRENCY = 4;
+  static readonly MAX_BULK_CONCURRENCY = 10;
+
+  private bulkConcurrency(concurrency?: number): number {
+    if (concurrency === undefined) return TrelloClient.BULK_CONCURRENCY;
+    if (
+      !Number.isInteger(concurrency) ||
+      concurrency < 1 ||
+      concurrency > TrelloClient.MAX_BULK_CONCURRENCY
+    ) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `concurrency must be an integer from 1 to ${TrelloClient.MAX_BULK_CONCURRENCY}`
+      );
+    }
+    return concurrency;
+  }
+
+  /**
+   * Apply one label to many cards. A color is resolved to the board label once
+   * up front; cards are then updated with bounded concurrency. Cards that already
+   * carry the label are skipped, so re-running after a partial failure is safe.
+   */
+  async addLabelToCards(params: {
+    cardIds: string[];
+    labelId?: string;
+    color?: string;
+    boardId?: string;
+    concurrency?: number;
+  }): Promise<{ labelId: string; results: CardLabelResult[] }> {
+    let labelId = params.labelId;
+    if (!labelId) {
+      if (!params.color) {
+        throw new McpError(ErrorCode.InvalidParams, 'Either labelId or color must be provided');
+      }
+      const boardId =
+        params.boardId ||
+        this.effectiveDefaultBoardId ||
+        (await this.resolveCardBoardId(params.cardIds[0]));
+      labelId = (await this.findLabelByColor(boardId, params.color)).id;
+    }
+    const resolvedLabelId = labelId;
+
+    const settled = await mapWithConcurrency(
+      params.cardIds,
+      this.bulkConcurrency(params.concurrency),
+      async cardId => {
+        const { idLabels } = await this.getCardById(cardId, 'idLabels');
+        if (idLabels?.includes(resolvedLabelId)) {
+          return 'skipped' as const;
+        }
+        await this.addLabelToCard(cardId, resolvedLabelId);
+        return 'added' as const;
+      }
+    );
+    const results = settled.map((result, index): CardLabelResult => {
+      const cardId = params.cardIds[index];
+      if (result.status === 'rejected') {
+        return { cardId, success: false, error: rejectionMessage(result.reason) };
+      }
+      return {
+        cardId,
+        success: true,
+        status: result.value,
+        ...(result.value === 'skipped' && { reason: 'already present' }),
+      };
+    });
+    return { labelId: resolvedLabelId, results };
+  }
+
+  /**
+   * Cards on a board, archived ones included, that carry a label given by ID or
+   * by color (resolved to the board label)
+   */
+  async findCardsWithLabel(params: {
+    labelId?: string;
+    color?: string;
+    boardId?: string;
+  }): Promise<{ labelId: string; cards: Array<{ id: string; name: string }> }> {
+    let labelId = params.labelId;
+    if (!labelId) {
+      if (!params.color) {
+        throw new McpError(ErrorCode.InvalidParams, 'Either labelId or color must be provided');
+      }
+      labelId = (await this.findLabelByColor(params.boardId, params.color)).id;
+    }
+    const cards = await this.getBoardCards(params.boardId, 'name,idLabels', 'all');
+    return {
+      labelId,
+      cards: cards
+        .filter(card => card.idLabels?.includes(labelId))
+        .map(card => ({ id: card.id, name: card.name })),
+    };
+  }
+
+  /**
+   * Take one label off many cards, leaving the label itself on the board
+   */
+  async removeLabelFromCards(
+    labelId: string,
+    cardIds: string[],
+    concurrency?: number
+  ): Promise<{ removed: string[]; failures: Array<{ cardId: string; error: string }> }> {
+    const settled = await mapWithConcurrency(cardIds, this.bulkConcurrency(concurrency), cardId =>
+      this.removeLabelFromCard(cardId, labelId)
+    );
+    const { fulfilled, rejected } = partitionSettled(cardIds, settled);
+    return {
+      removed: fulfilled.map(({ item }) => item),
+      failures: rejected.map(({ item, error }) => ({ cardId: item, error })),
+    };
+  }
+
+  /**
+   * Remember a list's cards in memory so diffListSinceSnapshot can report changes
+   */
+  async snapshotList(listId: string): Promise<{ listId: string; takenAt: string; cards: number }> {
+    const cards = await this.getCardsByList(listId, 'name,dateLastActivity,pos');
+    const snapshot = takeListSnapshot(listId, cards);
+    this.listSnapshots.set(listId, snapshot);
+    return { listId, takenAt: snapshot.takenAt, cards: cards.length };
+  }
+
+  /**
+   * Report what changed in a list since its last snapshot. Cards that left the list
+   * are looked up to tell moves from archives and deletions. By default the
+   * current state then becomes the new snapshot.
+   */
+  async diffListSinceSnapshot(listId: string, resetSnapshot: boolean = true): Promise<ListDiff> {
+    const snapshot = this.listSnapshots.get(listId);
+    if (!snapshot) {
       throw new McpError(
-        ErrorCode.InvalidParams,
-        'boardId is required when no default board is configured'
+        ErrorCode.InvalidRequest,
+        `No snapshot for list ${listId}. Call snapshot_list first.`
       );
     }
-    return this.handleRequest(async () => {
-      const response = await this.axiosInstance.post(`/boards/${effectiveBoardId}/labels`, {
-        name,
-        color,
-      });
-      return response.data;
+    const cards = await this.getCardsByList(listId, 'name,dateLastActivity,pos');
+    const diff = diffListSnapshot(snapshot, cards);
+
+    const settled = await mapWithConcurrency(diff.removed, TrelloClient.BULK_CONCURRENCY, entry =>
+      this.getCardById(entry.id, 'idList,closed')
+    );
+    settled.forEach((result, i) => {
+      const entry = diff.removed[i];
+      if (result.status === 'rejected') {
+        entry.status = 'deleted';
+      } else if (result.value.closed) {
+        entry.status = 'archived';
+      } else {
+        entry.status = 'moved';
+        entry.idList = result.value.idList;
+      }
     });
-  }
 
-  async updateLabel(
-    labelId: string,
-    name?: string,
-    color?: string
-  ): Promise<TrelloLabelDetails> {
-    return this.handleRequest(async () => {
-      const updateData: { name?: string; color?: string } = {};
-      if (name !== undefined) updateData.name = name;
-      if (color !== undefined) updateData.color = color;
+    if (resetSnapshot) {
+      this.listSnapshots.set(listId, takeListSnapshot(listId, cards));
+    }
+    return diff;
+  }
 
-      const response = await this.axiosInstance.put(`/labels/${labelId}`, updateData);
-      return response.data;
-    });
+  /**
+   * Open cards on a list, or on a whole board, with no activity for more than
+   * olderThanDays. Fetches only the fields needed to decide.
+   */
+  async findInactiveCards(params: {
+    listId?: string;
+    boardId?: string;
+    olderThanDays: number;
+  }): Promise<{
+    cutoff: string;
+    cards: Array<{ id: string; name: string; dateLastActivity: string }>;
+  }> {
+    const fields = 'name,dateLastActivity';
+    const cards = params.listId
+      ? await this.getCardsByList(params.listId, fields)
+      : await this.getBoardCards(params.boardId, fields, 'open');
+    const cutoff = new Date(
+      Date.now() - params.olderThanDays * 24 * 60 * 60 * 1000
+    ).toISOString();
+    return {
+      cutoff,
+      cards: cards
+        .filter(card => card.dateLastActivity < cutoff)
+        .map(card => ({ id: card.id, name: card.name, dateLastActivity: card.dateLastActivity })),
+    };
   }
 
-  async deleteLabel(labelId: string): Promise<boolean> {
-    return this.handleRequest(async () => {
-      await this.axiosInstance.delete(`/labels/${labelId}`);
-      return true;
-    });
+  /**
+   * Archive many cards with bounded concurrency; each card succeeds or fails on its own
+   */
+  async archiveCards(
+    cardIds: string[],
+    concurrency?: number
+  ): Promise<{ archived: string[]; failures: Array<{ cardId: string; error: string }> }> {
+    const settled = await mapWithConcurrency(cardIds, this.bulkConcurrency(concurrency), cardId =>
+      this.archiveCard(undefined, cardId)
+    );
+    const { fulfilled, rejected } = partitionSettled(cardIds, settled);
+    return {
+      archived: fulfilled.map(({ item }) => item),
+      failures: rejected.map(({ item, error }) => ({ cardId: item, error })),
+    };
   }
 
-  async searchLabels(boardId: string | undefined, query: string): Promise<TrelloLabelDetails[]> {
-    const labels = await this.getBoardLabels(boardId);
-    const normalizedQuery = query.trim().toLowerCase();
-    if (!normalizedQuery) {
-      return [];
+  /**
+   * Reorder every card in a list by a key. Cards get evenly spaced positions in
+   * the new order; only cards whose position changes are updated.
+   */
+  async sortList(params: {
+    listId: string;
+    sortBy: CardSortKey;
+    order: 'asc' | 'desc';
+    boardId?: string;
+    concurrency?: number;
+  }): Promise<{
+    order: Array<{ id: string; name: string; pos: number }>;
+    failures: Array<{ cardId: string; error: string }>;
+  }> {
+    if (params.boardId) {
+      await this.assertListOnBoard(params.listId, params.boardId);
     }
+    const cards = await this.getCardsByList(params.listId, 'name,due,dateLastActivity,pos');
+    const sorted = sortCards(cards, params.sortBy, params.order);
+    const targets = sorted.map((card, i) => ({ card, pos: (i + 1) * POSITION_STEP }));
+    const failures = await this.applyCardPositions(
+      targets.filter(({ card, pos }) => card.pos !== pos),
+      params.concurrency
+    );
+    return {
+      order: targets.map(({ card, pos }) => ({ id: card.id, name: card.name, pos })),
+      failures,
+    };
+  }
 
-    return labels.filter(label => {
-      const normalizedName = label.name.toLowerCase();
-      const normalizedColor = label.color?.toLowerCase() ?? '';
+  /**
+   * Reassign evenly spaced positions to a list's cards, keeping their current order.
+   * Only cards whose position changes are updated; dryRun reports without writing.
+   */
+  async normalizeListPositions(params: {
+    listId: string;
+    boardId?: string;
+    dryRun?: boolean;
+    concurrency?: number;
+  }): Promise<{
+    changes: Array<{ id: string; name: string; before: number; after: number }>;
+    failures: Array<{ cardId: string; error: string }>;
+  }> {
+    if (params.boardId) {
+      await this.assertListOnBoard(params.listId, params.boardId);
+    }
+    const cards = await this.getCardsByList(params.listId, 'name,pos');
+    const targets = [...cards]
+      .sort((a, b) => a.pos - b.pos)
+      .map((card, i) => ({ card, pos: (i + 1) * POSITION_STEP }))
+      .filter(({ card, pos }) => card.pos !== pos);
+    const failures = params.dryRun
+      ? []
+      : await this.applyCardPositions(targets, params.concurrency);
+    return {
+      changes: targets.map(({ card, pos }) => ({
+        id: card.id,
+        name: card.name,
+        before: card.pos,
+        after: pos,
+      })),
+      failures,
+    };
+  }
 
-      return (
-        normalizedName.includes(normalizedQuery) || normalizedColor.includes(normalizedQuery)
+  private async assertListOnBoard(listId: string, boardId: string): Promise<void> {
+    const list = await this.getList(listId);
+    if (list.idBoard !== boardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `List ${listId} belongs to board ${list.idBoard}, not ${boardId}`
       );
-    });
+    }
+  }
+
+  private async applyCardPositions(
+    updates: Array<{ card: TrelloCard; pos: number }>,
+    concurrency?: number
+  ): Promise<Array<{ cardId: string; error: string }>> {
+    const settled = await mapWithConcurrency(
+      updates,
+      this.bulkConcurrency(concurrency),
+      ({ card, pos }) =>
+        this.handleRequest(async () => {
+          await this.axiosInstance.put(`/cards/${card.id}`, { pos });
+        })
+    );
+    return partitionSettled(updates, settled).rejected.map(({ item, error }) => ({
+      cardId: item.card.id,
+      error,
+    }));
   }
 
   async removeLabelFromCard(cardId: string, labelId: string): Promise<boolean> {
@@ -1172,6 +4042,37 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Make a card's labels exactly `labels`, issuing only the adds and removes needed.
+   * Entries are label IDs or color names; a color must match a single label on the
+   * card's board.
+   */
+  async setCardLabels(
+    cardId: string,
+    labels: string[]
+  ): Promise<{ added: string[]; removed: string[]; unchanged: string[] }> {
+    const card = await this.getCardById(cardId, 'idLabels,idBoard');
+    const desired = new Set<string>();
+    for (const entry of labels) {
+      desired.add(
+        isTrelloId(entry) ? entry : (await this.findLabelByColor(card.idBoard, entry)).id
+      );
+    }
+    const current = new Set(card.idLabels ?? []);
+
+    const added = [...desired].filter(id => !current.has(id));
+    const removed = [...current].filter(id => !desired.has(id));
+    const unchanged = [...current].filter(id => desired.has(id));
+
+    for (const labelId of added) {
+      await this.addLabelToCard(cardId, labelId);
+    }
+    for (const labelId of removed) {
+      await this.removeLabelFromCard(cardId, labelId);
+    }
+    return { added, removed, unchanged };
+  }
+
   /**
    * Copy a card (can copy across boards). Uses idCardSource to clone a card.
    */
@@ -1196,6 +4097,37 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Copy a card into each of several lists, continuing past failures. Created card
+   * IDs are keyed by target list.
+   */
+  async duplicateCardToLists(params: {
+    sourceCardId: string;
+    listIds: string[];
+    keepFromSource?: string;
+    concurrency?: number;
+  }): Promise<{
+    created: Record<string, string>;
+    failures: Array<{ listId: string; error: string }>;
+  }> {
+    const listIds = [...new Set(params.listIds)];
+    const settled = await mapWithConcurrency(
+      listIds,
+      this.bulkConcurrency(params.concurrency),
+      listId =>
+        this.copyCard({
+          sourceCardId: params.sourceCardId,
+          listId,
+          keepFromSource: params.keepFromSource,
+        })
+    );
+    const { fulfilled, rejected } = partitionSettled(listIds, settled);
+    return {
+      created: Object.fromEntries(fulfilled.map(({ item, value }) => [item, value.id])),
+      failures: rejected.map(({ item, error }) => ({ listId: item, error })),
+    };
+  }
+
   /**
    * Copy a checklist from one card to another (can copy across boards).
    */
@@ -1216,12 +4148,72 @@ export class TrelloClient {
     });
   }
 
+  /**
+   * Copy a checklist from one card to another, picking the source checklist by
+   * ID or by name on the source card. With resetStates every copied item starts
+   * out incomplete, whatever its state on the source; items that could not be
+   * reset are reported in resetFailures.
+   */
+  async copyChecklistToCard(params: {
+    sourceCardId: string;
+    sourceChecklist: string;
+    targetCardId: string;
+    name?: string;
+    resetStates?: boolean;
+  }): Promise<{
+    id: string;
+    name: string;
+    itemCount: number;
+    resetItems: number;
+    resetFailures: Array<{ itemId: string; error: string }>;
+  }> {
+    const checklists = await this.loadChecklistsForLookup(params.sourceCardId);
+    const source =
+      checklists.find(checklist => checklist.id === params.sourceChecklist) ??
+      resolveByName(checklists, params.sourceChecklist, {
+        kind: 'Checklist',
+        names: checklist => [checklist.name],
+        mode: this.nameMatching,
+        idParam: 'the checklist ID',
+        notFoundHint: `Card ${params.sourceCardId} has: ${checklists.map(checklist => checklist.name).join(', ') || 'no checklists'}.`,
+      });
+
+    const copy = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.post<TrelloChecklist>(
+        `/cards/${params.targetCardId}/checklists`,
+        {
+          idChecklistSource: source.id,
+          ...(params.name && { name: params.name }),
+        }
+      );
+      return response.data;
+    });
+
+    const items = copy.checkItems ?? [];
+    const completed = params.resetStates ? items.filter(item => item.state === 'complete') : [];
+    const settled = await mapWithConcurrency(completed, this.bulkConcurrency(), item =>
+      this.handleRequest(() =>
+        this.axiosInstance.put(`/cards/${params.targetCardId}/checkItem/${item.id}`, {
+          state: 'incomplete',
+        })
+      )
+    );
+    const { fulfilled, rejected } = partitionSettled(completed, settled);
+    return {
+      id: copy.id,
+      name: copy.name,
+      itemCount: items.length,
+      resetItems: fulfilled.length,
+      resetFailures: rejected.map(({ item, error }) => ({ itemId: item.id, error })),
+    };
+  }
+
   static readonly BATCH_ADD_CARDS_LIMIT = 50;
 
   /**
    * Add multiple cards to a list. Trello has no native batch write endpoint,
-   * so this makes sequential POST /1/cards calls.
-   * Returns created cards and any errors, so callers can see partial progress.
+   * so this is createCardsBulk with every card in the same list.
+   * Returns created cards (in input order) and any errors, so callers can see partial progress.
    */
   async batchAddCards(
     listId: string,
@@ -1231,36 +4223,359 @@ export class TrelloClient {
       dueDate?: string;
       start?: string;
       labels?: string[];
-    }>
+    }>,
+    concurrency?: number
   ): Promise<{ created: TrelloCard[]; errors: Array<{ index: number; name: string; error: string }> }> {
+    const results = await this.createCardsBulk(
+      cards.map(card => ({
+        listId,
+        name: card.name,
+        desc: card.description,
+        due: card.dueDate,
+        start: card.start,
+        labels: card.labels,
+      })),
+      concurrency
+    );
+    return {
+      created: results.flatMap(result => (result.card ? [result.card] : [])),
+      errors: results.flatMap(({ index, name, error }) =>
+        error === undefined ? [] : [{ index, name, error }]
+      ),
+    };
+  }
+
+  /**
+   * Create cards across any number of lists with bounded concurrency. Each card
+   * succeeds or fails on its own; results keep the input order.
+   */
+  async createCardsBulk(
+    cards: Array<{
+      listId: string;
+      name: string;
+      desc?: string;
+      due?: string;
+      start?: string;
+      labels?: string[];
+      members?: string[];
+    }>,
+    concurrency?: number
+  ): Promise<
+    Array<{
+      index: number;
+      name: string;
+      success: boolean;
+      id?: string;
+      card?: TrelloCard;
+      error?: string;
+    }>
+  > {
     if (cards.length > TrelloClient.BATCH_ADD_CARDS_LIMIT) {
       throw new McpError(
         ErrorCode.InvalidParams,
         `Cannot create more than ${TrelloClient.BATCH_ADD_CARDS_LIMIT} cards at once (got ${cards.length})`
       );
     }
-    const created: TrelloCard[] = [];
-    const errors: Array<{ index: number; name: string; error: string }> = [];
-    for (let i = 0; i < cards.length; i++) {
+    const settled = await mapWithConcurrency(cards, this.bulkConcurrency(concurrency), card =>
+      this.addCard(undefined, {
+        listId: card.listId,
+        name: card.name,
+        description: card.desc,
+        dueDate: card.due,
+        start: card.start,
+        labels: card.labels,
+        members: card.members,
+      })
+    );
+    return settled.map((result, index) =>
+      result.status === 'fulfilled'
+        ? { index, name: cards[index].name, success: true, id: result.value.id, card: result.value }
+        : { index, name: cards[index].name, success: false, error: rejectionMessage(result.reason) }
+    );
+  }
+
+  /** Exports larger than this are written to disk instead of returned inline */
+  static readonly EXPORT_INLINE_LIMIT_BYTES = 200_000;
+
+  /**
+   * Export a board's lists, cards, checklists, labels, and members (and optionally
+   * comments) as one normalized document, fetched in a single nested board request.
+   */
+  async exportBoard(
+    boardId: string | undefined,
+    options: { includeClosed: boolean; includeComments: boolean }
+  ): Promise<BoardExport> {
+    const effectiveBoardId = boardId || this.activeConfig.boardId || this.defaultBoardId;
+    if (!effectiveBoardId) {
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        'boardId is required when no default board is configured'
+      );
+    }
+    const filter = options.includeClosed ? 'all' : 'open';
+    const raw = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/boards/${effectiveBoardId}`, {
+        params: {
+          fields: 'name,desc',
+          labels: 'all',
+          label_fields: 'name,color',
+          labels_limit: 1000,
+          members: 'all',
+          member_fields: 'username,fullName',
+          lists: filter,
+          list_fields: 'name,closed,pos',
+          cards: filter,
+          card_fields: 'idList,name,desc,closed,pos,due,start,dueComplete,idLabels,idMembers',
+          card_attachments: true,
+          card_attachment_fields: 'name,url,isUpload',
+          checklists: 'all',
+          checklist_fields: 'idCard,name,pos',
+          ...(options.includeComments && { actions: 'commentCard', actions_limit: 1000 }),
+        },
+      });
+      return response.data;
+    });
+    return buildBoardExport(raw, { includeComments: options.includeComments });
+  }
+
+  /**
+   * Where exports are written, and the only place import_board_json may read from
+   */
+  private get exportDir(): string {
+    return path.resolve(this.config.exportDir || path.join(CONFIG_DIR, 'exports'));
+  }
+
+  /**
+   * Write an export to the export directory and return the file path
+   */
+  async saveBoardExport(doc: BoardExport, json: string): Promise<string> {
+    const exportDir = this.exportDir;
+    await fs.mkdir(exportDir, { recursive: true });
+    const stamp = new Date().toISOString().replace(/[:.]/g, '-');
+    const filePath = path.join(exportDir, `board-${doc.board.id}-${stamp}.json`);
+    await fs.writeFile(filePath, json);
+    return filePath;
+  }
+
+  /**
+   * Read and validate a board export previously written by saveBoardExport.
+   * Relative paths are resolved against the export directory, and anything
+   * outside it (including through a symlink) is refused.
+   */
+  async readBoardExport(filePath: string): Promise<BoardExport> {
+    const exportDir = this.exportDir;
+    const outside = new McpError(
+      ErrorCode.InvalidParams,
+      `Export file must be inside the export directory (${exportDir}): ${filePath}`
+    );
+    const requested = path.resolve(exportDir, filePath);
+    if (!isWithinDirectory(exportDir, requested)) {
+      throw outside;
+    }
+    let json: string;
+    try {
+      const [realDir, realFile] = await Promise.all([
+        fs.realpath(exportDir),
+        fs.realpath(requested),
+      ]);
+      if (!isWithinDirectory(realDir, realFile)) {
+        throw outside;
+      }
+      json = await fs.readFile(realFile, 'utf8');
+    } catch (error) {
+      if (error instanceof McpError) throw error;
+      throw new McpError(ErrorCode.InvalidParams, `Cannot read export file: ${filePath}`);
+    }
+    try {
+      return parseBoardExport(JSON.parse(json));
+    } catch (error) {
+      if (error instanceof McpError) throw error;
+      throw new McpError(ErrorCode.InvalidParams, `Export file is not valid JSON: ${filePath}`);
+    }
+  }
+
+  /**
+   * Recreate an exported board's labels, lists, cards, and checklists, either on a
+   * new board or appended to targetBoardId. Old IDs are mapped to new ones so cards
+   * keep their list and labels. Items Trello cannot recreate from the export (uploaded
+   * attachments, comments, member assignments) and per-item failures are reported
+   * in skipped rather than aborting the import.
+   */
+  async importBoard(
+    doc: BoardExport,
+    params: { targetBoardId?: string; name?: string; idOrganization?: string }
+  ): Promise<BoardImportSummary> {
+    const boardId = params.targetBoardId
+      ? params.targetBoardId
+      : (
+          await this.createBoard({
+            name: params.name || doc.board.name,
+            desc: doc.board.desc || undefined,
+            idOrganization: params.idOrganization,
+            defaultLabels: false,
+            defaultLists: false,
+          })
+        ).id;
+    const summary: BoardImportSummary = {
+      boardId,
+      created: { labels: 0, lists: 0, cards: 0, checklists: 0, checkItems: 0, attachments: 0 },
+      reusedLabels: 0,
+      skipped: [],
+    };
+    const skip = (type: string, id: string, name: string | undefined, reason: unknown) =>
+      summary.skipped.push({
+        type,
+        id,
+        ...(name !== undefined && { name }),
+        reason: reason instanceof Error ? reason.message : String(reason),
+      });
+
+    // Labels: reuse an identical name/color label on an existing board
+    const existingLabels = params.targetBoardId ? await this.getBoardLabels(boardId) : [];
+    const labelMap = new Map<string, string>();
+    for (const label of doc.labels) {
+      const match = existingLabels.find(
+        existing => existing.name === label.name && (existing.color ?? null) === label.color
+      );
+      if (match) {
+        labelMap.set(label.id, match.id);
+        summary.reusedLabels++;
+        continue;
+      }
       try {
-        const result = await this.addCard(undefined, {
-          listId,
-          name: cards[i].name,
-          description: cards[i].description,
-          dueDate: cards[i].dueDate,
-          start: cards[i].start,
-          labels: cards[i].labels,
+        const created = await this.createLabel(boardId, label.name, label.color ?? undefined);
+        labelMap.set(label.id, created.id);
+        summary.created.labels++;
+      } catch (error) {
+        skip('label', label.id, label.name, error);
+      }
+    }
+
+    // Lists are created in export order, so appending to the bottom preserves it
+    const listMap = new Map<string, string>();
+    for (const list of doc.lists) {
+      try {
+        const created = await this.handleRequest(async () => {
+          const response = await this.axiosInstance.post('/lists', {
+            name: list.name,
+            idBoard: boardId,
+            pos: 'bottom',
+          });
+          return response.data as TrelloList;
         });
-        created.push(result);
+        listMap.set(list.id, created.id);
+        summary.created.lists++;
+        if (list.closed) {
+          await this.handleRequest(() =>
+            this.axiosInstance.put(`/lists/${created.id}`, { closed: true })
+          );
+        }
       } catch (error) {
-        errors.push({
-          index: i,
-          name: cards[i].name,
-          error: error instanceof Error ? error.message : 'Unknown error',
+        skip('list', list.id, list.name, error);
+      }
+    }
+
+    for (const card of doc.cards) {
+      const idList = listMap.get(card.idList);
+      if (!idList) {
+        skip('card', card.id, card.name, 'its list was not imported');
+        continue;
+      }
+      let newCardId: string;
+      try {
+        const created = await this.handleRequest(async () => {
+          const response = await this.axiosInstance.post('/cards', {
+            idList,
+            name: card.name,
+            desc: card.desc,
+            pos: 'bottom',
+            due: card.due,
+            start: card.start,
+            dueComplete: card.dueComplete,
+            idLabels: card.idLabels
+              .map(id => labelMap.get(id))
+              .filter((id): id is string => id !== undefined)
+              .join(','),
+          });
+          return response.data as TrelloCard;
         });
+        newCardId = created.id;
+        summary.created.cards++;
+        if (card.closed) {
+          await this.handleRequest(() =>
+            this.axiosInstance.put(`/cards/${newCardId}`, { closed: true })
+          );
+        }
+      } catch (error) {
+        skip('card', card.id, card.name, error);
+        continue;
+      }
+
+      for (const checklist of card.checklists) {
+        let newChecklistId: string;
+        try {
+          const created = await this.handleRequest(async () => {
+            const response = await this.axiosInstance.post(`/cards/${newCardId}/checklists`, {
+              name: checklist.name,
+            });
+            return response.data as TrelloChecklist;
+          });
+          newChecklistId = created.id;
+          summary.created.checklists++;
+        } catch (error) {
+          skip('checklist', checklist.id, checklist.name, error);
+          continue;
+        }
+        for (const item of checklist.items) {
+          try {
+            await this.handleRequest(() =>
+              this.axiosInstance.post(`/checklists/${newChecklistId}/checkItems`, {
+                name: item.name,
+                checked: item.state === 'complete',
+                pos: 'bottom',
+                ...(item.due && { due: item.due }),
+              })
+            );
+            summary.created.checkItems++;
+          } catch (error) {
+            skip('checkItem', item.id, item.name, error);
+          }
+        }
+      }
+
+      for (const attachment of card.attachments) {
+        if (attachment.isUpload) {
+          skip('attachment', attachment.id, attachment.name, 'uploaded file must be re-uploaded');
+          continue;
+        }
+        try {
+          await this.handleRequest(() =>
+            this.axiosInstance.post(`/cards/${newCardId}/attachments`, {
+              url: attachment.url,
+              name: attachment.name,
+            })
+          );
+          summary.created.attachments++;
+        } catch (error) {
+          skip('attachment', attachment.id, attachment.name, error);
+        }
+      }
+
+      const assigned =
+        card.idMembers.length +
+        card.checklists.reduce(
+          (count, checklist) => count + checklist.items.filter(item => item.idMember).length,
+          0
+        );
+      if (assigned > 0) {
+        skip('members', card.id, card.name, `${assigned} member assignment(s) not imported`);
+      }
+      if (card.comments?.length) {
+        skip('comments', card.id, card.name, `${card.comments.length} comment(s) not imported`);
       }
     }
-    return { created, errors };
+
+    return summary;
   }
 
   // Custom field management methods
@@ -1321,22 +4636,108 @@ export class TrelloClient {
     });
   }
 
+  static readonly CUSTOM_FIELD_CACHE_TTL_MS = 5 * 60 * 1000;
+
+  /**
+   * A card's custom field values decoded against its board's field definitions.
+   * Definitions are cached per board for CUSTOM_FIELD_CACHE_TTL_MS.
+   */
+  async getCardCustomFieldValues(cardId: string): Promise<DecodedCustomFieldValue[]> {
+    const card = await this.handleRequest(async () => {
+      const response = await this.axiosInstance.get(`/cards/${cardId}`, {
+        params: { fields: 'idBoard', customFieldItems: true },
+      });
+      return response.data as { idBoard: string; customFieldItems?: TrelloCustomFieldItem[] };
+    });
+    const items = card.customFieldItems ?? [];
+    if (items.length === 0) {
+      return [];
+    }
+    const definitions = await this.getCachedCustomFieldDefinitions(card.idBoard);
+    return decodeCustomFieldItems(items, definitions);
+  }
+
+  private getCachedCustomFieldDefinitions(boardId: string): Promise<TrelloCustomFieldDefinition[]> {
+    const now = Date.now();
+    const cached = this.customFieldDefinitions.get(boardId);
+    if (cached && cached.expiresAt > now) {
+      return cached.definitions;
+    }
+    const definitions = this.getBoardCustomFields(boardId);
+    this.customFieldDefinitions.set(boardId, {
+      definitions,
+      expiresAt: now + TrelloClient.CUSTOM_FIELD_CACHE_TTL_MS,
+    });
+    definitions.catch(() => this.customFieldDefinitions.delete(boardId));
+    return definitions;
+  }
+
   // Card history method
   async getCardHistory(
     cardId: string,
     filter?: string,
-    limit?: number
+    limit?: number,
+    before?: string
   ): Promise<TrelloAction[]> {
     return this.handleRequest(async () => {
-      const params: { filter?: string; limit?: number } = {};
+      const params: { filter?: string; limit?: number; before?: string } = {};
       if (filter) params.filter = filter;
       if (limit) params.limit = limit;
+      if (before) params.before = before;
 
       const response = await this.axiosInstance.get(`/cards/${cardId}/actions`, { params });
       return response.data;
     });
   }
 
+  /**
+   * The lists a card has lived in, oldest first, with how long it stayed in each
+   */
+  async getCardListHistory(
+    cardId: string,
+    limit: number = 100
+  ): Promise<{
+    cardId: string;
+    timeline: ListStay[];
+    dwellByList: Array<{ list: { id: string; name: string }; dwellHours: number }>;
+  }> {
+    const actions = await this.getCardHistory(cardId, LIST_HISTORY_ACTION_TYPES.join(','), limit);
+    return { cardId, ...buildListTimeline(actions) };
+  }
+
+  /**
+   * Card history as compact one-line summaries, newest first
+   */
+  async getCardActionsSummary(cardId: string, limit?: number, types?: string[]): Promise<string[]> {
+    const filter = (types && types.length > 0 ? types : SUMMARY_ACTION_TYPES).join(',');
+    const actions = await this.getCardHistory(cardId, filter, limit);
+    return actions.map(summarizeAction);
+  }
+
+  private get oauthHeader(): string {
+    return 'OAuth oauth_consumer_key="' + this.config.apiKey + '", oauth_token="' + this.config.token + '"';
+  }
+
+  /**
+   * Fetch an attachment's content with a size cap, images only unless allowAnyType is set
+   */
+  async getAttachmentContent(
+    cardId: string,
+    attachmentId: string,
+    options: { maxBytes?: number; allowAnyType?: boolean } = {}
+  ): Promise<{ data: string; mimeType: string; fileName: string; bytes: number }> {
+    return this.handleRequest(() =>
+      attachments.getAttachmentContent(this.axiosInstance, {
+        cardId,
+        attachmentId,
+        maxBytes:
+          options.maxBytes ?? this.config.maxAttachmentBytes ?? attachments.DEFAULT_MAX_ATTACHMENT_BYTES,
+        allowAnyType: options.allowAnyType ?? false,
+        authorization: this.oauthHeader,
+      })
+    );
+  }
+
   /**
    * Download an attachment from a card with authentication
    * Returns base64-encoded data along with metadata
@@ -1356,7 +4757,7 @@ export class TrelloClient {
       const downloadUrl = `https://api.trello.com/1/cards/${cardId}/attachments/${attachmentId}/download/${encodeURIComponent(attachment.fileName)}`;
       const response = await this.axiosInstance.get(downloadUrl, {
         headers: {
-          Authorization: 'OAuth oauth_consumer_key="' + this.config.apiKey + '", oauth_token="' + this.config.token + '"',
+          Authorization: this.oauthHeader,
         },
         responseType: 'arraybuffer',
       });
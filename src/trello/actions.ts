@@ -0,0 +1,169 @@
+import { TrelloAction } from '../types.js';
+
+/** Action types summarized by default: moves, labels, members, dates, and comments */
+export const SUMMARY_ACTION_TYPES = [
+  'createCard',
+  'copyCard',
+  'updateCard',
+  'commentCard',
+  'addLabelToCard',
+  'removeLabelFromCard',
+  'addMemberToCard',
+  'removeMemberFromCard',
+];
+
+const COMMENT_PREVIEW_LENGTH = 80;
+
+/**
+ * Render one action as a single timeline line, e.g. "2024-05-01 Jane moved from Doing to Done"
+ */
+export function summarizeAction(action: TrelloAction): string {
+  const date = action.date.slice(0, 10);
+  const actor = action.memberCreator?.fullName || action.memberCreator?.username || 'Someone';
+  return `${date} ${actor} ${describeAction(action)}`;
+}
+
+function describeAction(action: TrelloAction): string {
+  const data = action.data;
+  switch (action.type) {
+    case 'createCard':
+      return data.list ? `created the card in ${data.list.name}` : 'created the card';
+    case 'copyCard':
+      return 'copied the card';
+    case 'commentCard': {
+      const text = (data.text ?? '').replace(/\s+/g, ' ').trim();
+      const preview =
+        text.length > COMMENT_PREVIEW_LENGTH ? `${text.slice(0, COMMENT_PREVIEW_LENGTH - 3)}...` : text;
+      return `commented: "${preview}"`;
+    }
+    case 'addLabelToCard':
+      return `added label "${data.label?.name || data.label?.color || 'unknown'}"`;
+    case 'removeLabelFromCard':
+      return `removed label "${data.label?.name || data.label?.color || 'unknown'}"`;
+    case 'addMemberToCard':
+      return data.idMember === action.memberCreator?.id
+        ? 'joined the card'
+        : `added ${action.member?.fullName ?? 'a member'}`;
+    case 'removeMemberFromCard':
+      return data.idMember === action.memberCreator?.id
+        ? 'left the card'
+        : `removed ${action.member?.fullName ?? 'a member'}`;
+    case 'updateCard':
+      return describeCardUpdate(action);
+    default:
+      return action.type;
+  }
+}
+
+function describeCardUpdate(action: TrelloAction): string {
+  const { data } = action;
+  const old = data.old ?? {};
+
+  if (data.listAfter) {
+    return data.listBefore
+      ? `moved from ${data.listBefore.name} to ${data.listAfter.name}`
+      : `moved to ${data.listAfter.name}`;
+  }
+  if ('due' in old) {
+    return data.card?.due ? `set due date to ${data.card.due.slice(0, 10)}` : 'removed the due date';
+  }
+  if ('dueComplete' in old) {
+    return data.card?.dueComplete ? 'marked the due date complete' : 'marked the due date incomplete';
+  }
+  if ('closed' in old) {
+    return data.card?.closed ? 'archived the card' : 'restored the card';
+  }
+  if ('name' in old) {
+    return `renamed the card to "${data.card?.name ?? ''}"`;
+  }
+  if ('desc' in old) {
+    return 'updated the description';
+  }
+  if ('pos' in old) {
+    return 'reordered the card';
+  }
+  return `updated the card (${Object.keys(old).join(', ') || 'details'})`;
+}
+
+/** Actions that place a card in a list: creation, copies, and list moves */
+export const LIST_HISTORY_ACTION_TYPES = ['createCard', 'copyCard', 'updateCard:idList'];
+
+export interface ListStay {
+  list: { id: string; name: string };
+  /** When the card arrived; null if that predates the fetched actions */
+  enteredAt: string | null;
+  /** When the card left; null while it is still there */
+  leftAt: string | null;
+  dwellHours: number | null;
+  movedBy: string | null;
+}
+
+const HOUR_MS = 60 * 60 * 1000;
+
+function hoursBetween(from: string | null, to: string): number | null {
+  if (!from) return null;
+  return Math.round(((Date.parse(to) - Date.parse(from)) / HOUR_MS) * 10) / 10;
+}
+
+/**
+ * Turn a card's creation and list-move actions (any order) into the lists it has
+ * lived in, oldest first, plus total hours spent in each list.
+ */
+export function buildListTimeline(
+  actions: TrelloAction[],
+  now: Date = new Date()
+): {
+  timeline: ListStay[];
+  dwellByList: Array<{ list: { id: string; name: string }; dwellHours: number }>;
+} {
+  const ordered = [...actions].sort(
+    (a, b) => a.date.localeCompare(b.date) || a.id.localeCompare(b.id)
+  );
+  const timeline: ListStay[] = [];
+  const actor = (action: TrelloAction) =>
+    action.memberCreator?.fullName || action.memberCreator?.username || null;
+
+  for (const action of ordered) {
+    const arrived = action.type === 'updateCard' ? action.data.listAfter : action.data.list;
+    if (!arrived) continue;
+    const current = timeline[timeline.length - 1];
+    if (current) {
+      current.leftAt = action.date;
+      current.dwellHours = hoursBetween(current.enteredAt, action.date);
+    } else if (action.type === 'updateCard' && action.data.listBefore) {
+      // The card was created before the oldest fetched action
+      timeline.push({
+        list: { id: action.data.listBefore.id, name: action.data.listBefore.name },
+        enteredAt: null,
+        leftAt: action.date,
+        dwellHours: null,
+        movedBy: null,
+      });
+    }
+    timeline.push({
+      list: { id: arrived.id, name: arrived.name },
+      enteredAt: action.date,
+      leftAt: null,
+      dwellHours: null,
+      movedBy: actor(action),
+    });
+  }
+
+  const last = timeline[timeline.length - 1];
+  if (last) {
+    last.dwellHours = hoursBetween(last.enteredAt, now.toISOString());
+  }
+
+  const totals = new Map<string, { list: { id: string; name: string }; dwellHours: number }>();
+  for (const stay of timeline) {
+    if (stay.dwellHours === null) continue;
+    const total = totals.get(stay.list.id) ?? { list: stay.list, dwellHours: 0 };
+    total.dwellHours = Math.round((total.dwellHours + stay.dwellHours) * 10) / 10;
+    totals.set(stay.list.id, total);
+  }
+
+  return {
+    timeline,
+    dwellByList: [...totals.values()].sort((a, b) => b.dwellHours - a.dwellHours),
+  };
+}
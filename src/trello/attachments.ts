@@ -1,4 +1,4 @@
-import { AxiosInstance } from 'axios';
+import axios, { AxiosInstance } from 'axios';
 import FormData from 'form-data';
 import * as fs from 'fs/promises';
 import * as path from 'path';
@@ -241,3 +241,117 @@ export async function getCardAttachments(
   const response = await axiosInstance.get(`/cards/${cardId}/attachments`);
   return response.data;
 }
+
+export interface RenameAttachmentParams {
+  cardId: string;
+  attachmentId: string;
+  name: string;
+}
+
+/**
+ * Rename an attachment. Only the name is sent so the URL and file stay as they
+ * are. Trello refuses to rename some uploaded files; when it does, the
+ * attachment is looked up so the error can say why.
+ */
+export async function renameAttachment(
+  axiosInstance: AxiosInstance,
+  { cardId, attachmentId, name }: RenameAttachmentParams
+): Promise<TrelloAttachment> {
+  const trimmed = name.trim();
+  if (!trimmed) {
+    throw new McpError(ErrorCode.InvalidParams, 'Attachment name must not be empty');
+  }
+  try {
+    const response = await axiosInstance.put(`/cards/${cardId}/attachments/${attachmentId}`, {
+      name: trimmed,
+    });
+    return response.data;
+  } catch (error) {
+    const status = axios.isAxiosError(error) ? error.response?.status : undefined;
+    if (status !== 400 && status !== 403) throw error;
+    const metaResponse = await axiosInstance
+      .get(`/cards/${cardId}/attachments/${attachmentId}`)
+      .catch(() => undefined);
+    const attachment: TrelloAttachment | undefined = metaResponse?.data;
+    if (attachment?.isUpload) {
+      throw new McpError(
+        ErrorCode.InvalidRequest,
+        `Trello rejected renaming ${attachment.fileName || attachment.name}: uploaded files may not be renamable, only link (URL) attachments`
+      );
+    }
+    throw error;
+  }
+}
+
+export const DEFAULT_MAX_ATTACHMENT_BYTES = 5 * 1024 * 1024;
+
+export interface AttachmentContentParams {
+  cardId: string;
+  attachmentId: string;
+  maxBytes: number;
+  allowAnyType: boolean;
+  authorization: string;
+}
+
+/**
+ * Fetch an uploaded attachment's bytes as base64. Only images are returned unless
+ * allowAnyType is set. The size cap is checked against the metadata first and
+ * then enforced while streaming, so an oversized download is aborted early.
+ */
+export async function getAttachmentContent(
+  axiosInstance: AxiosInstance,
+  { cardId, attachmentId, maxBytes, allowAnyType, authorization }: AttachmentContentParams
+): Promise<{ data: string; mimeType: string; fileName: string; bytes: number }> {
+  const metaResponse = await axiosInstance.get(`/cards/${cardId}/attachments/${attachmentId}`);
+  const attachment: TrelloAttachment = metaResponse.data;
+
+  if (!attachment.isUpload) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Attachment ${attachmentId} is a link (${attachment.url}), not an uploaded file`
+    );
+  }
+  const fileName = attachment.fileName || attachment.name || 'attachment';
+  const mimeType = attachment.mimeType || mimeFromFilename(fileName) || DEFAULT_MIME_TYPE;
+  if (!allowAnyType && !mimeType.startsWith('image/')) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Attachment ${fileName} is ${mimeType}, not an image. Set allowAnyType to fetch it anyway.`
+    );
+  }
+  if (attachment.bytes && attachment.bytes > maxBytes) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Attachment ${fileName} is ${attachment.bytes} bytes, over the ${maxBytes} byte limit`
+    );
+  }
+
+  const downloadUrl = `https://api.trello.com/1/cards/${cardId}/attachments/${attachmentId}/download/${encodeURIComponent(fileName)}`;
+  const response = await axiosInstance.get(downloadUrl, {
+    headers: { Authorization: authorization },
+    responseType: 'stream',
+  });
+
+  const stream = response.data as NodeJS.ReadableStream & { destroy(): void };
+  const chunks: Buffer[] = [];
+  let total = 0;
+  for await (const chunk of stream) {
+    const buffer = Buffer.isBuffer(chunk) ? chunk : Buffer.from(chunk);
+    total += buffer.length;
+    if (total > maxBytes) {
+      stream.destroy();
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Attachment ${fileName} exceeds the ${maxBytes} byte limit`
+      );
+    }
+    chunks.push(buffer);
+  }
+
+  return {
+    data: Buffer.concat(chunks, total).toString('base64'),
+    mimeType,
+    fileName,
+    bytes: total,
+  };
+}
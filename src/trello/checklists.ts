@@ -1,14 +1,22 @@
 import { AxiosInstance } from 'axios';
-import { TrelloCheckItem, TrelloChecklist, CheckList } from '../types.js';
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+import { TrelloCheckItem, TrelloChecklist, CheckList, CheckListItem } from '../types.js';
+import { DEFAULT_NAME_MATCHING, filterByName, NameMatching } from './name-matching.js';
 
 /**
  * Get all checklists from a card with their items
  */
 export async function getCardChecklists(
   axiosInstance: AxiosInstance,
-  cardId: string
+  cardId: string,
+  options: { fields?: string } = {}
 ): Promise<CheckList[]> {
-  const response = await axiosInstance.get(`/cards/${cardId}/checklists`);
+  const response = await axiosInstance.get(`/cards/${cardId}/checklists`, {
+    params: {
+      checkItems: 'all',
+      ...(options.fields && { fields: options.fields }),
+    },
+  });
   const checklists: TrelloChecklist[] = response.data;
 
   return checklists.map((cl: TrelloChecklist) => ({
@@ -24,6 +32,125 @@ export async function getCardChecklists(
   }));
 }
 
+/**
+ * Locate a check item among a card's checklists, either by ID or by its text.
+ * Text matching is case-insensitive and can be narrowed to one checklist by ID or name
+ * (compared per nameMatching); more than one match is rejected rather than guessed.
+ */
+export function resolveCheckItem(
+  checklists: TrelloChecklist[],
+  query: {
+    checkItemId?: string;
+    checklistId?: string;
+    checklistName?: string;
+    itemText?: string;
+  },
+  nameMatching: NameMatching = DEFAULT_NAME_MATCHING
+): { checklist: TrelloChecklist; checkItem: TrelloCheckItem } {
+  let candidates = checklists;
+  if (query.checklistId) {
+    candidates = candidates.filter((cl) => cl.id === query.checklistId);
+  } else if (query.checklistName) {
+    candidates = filterByName(candidates, query.checklistName, (cl) => [cl.name], nameMatching);
+  }
+
+  if ((query.checklistId || query.checklistName) && candidates.length === 0) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Checklist ${query.checklistId ?? `"${query.checklistName}"`} not found on card`
+    );
+  }
+
+  const matches: Array<{ checklist: TrelloChecklist; checkItem: TrelloCheckItem }> = [];
+  for (const checklist of candidates) {
+    for (const checkItem of checklist.checkItems || []) {
+      const isMatch = query.checkItemId
+        ? checkItem.id === query.checkItemId
+        : query.itemText !== undefined &&
+          checkItem.name.toLowerCase() === query.itemText.toLowerCase();
+      if (isMatch) {
+        matches.push({ checklist, checkItem });
+      }
+    }
+  }
+
+  const label = query.checkItemId ?? `"${query.itemText}"`;
+  if (matches.length === 0) {
+    throw new McpError(ErrorCode.InvalidParams, `Checklist item ${label} not found on card`);
+  }
+  if (matches.length > 1) {
+    const where = matches.map((m) => `"${m.checklist.name}" (${m.checkItem.id})`).join(', ');
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Checklist item ${label} is ambiguous; found in ${where}. Pass checkItemId or checklistName to disambiguate.`
+    );
+  }
+  return matches[0];
+}
+
+const MAX_PATTERN_LENGTH = 200;
+
+/**
+ * Build a case-insensitive matcher for checklist item text: a substring match,
+ * or a regular expression when regex is set. Patterns are length-capped and
+ * nested quantifiers such as (a+)+ are refused, since a catastrophic pattern
+ * would stall the server.
+ */
+export function buildCheckItemMatcher(query: string, regex: boolean): (text: string) => boolean {
+  if (!regex) {
+    const needle = query.toLowerCase();
+    return (text) => text.toLowerCase().includes(needle);
+  }
+  if (query.length > MAX_PATTERN_LENGTH) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Pattern is longer than ${MAX_PATTERN_LENGTH} characters`
+    );
+  }
+  if (/\([^)]*[+*][^)]*\)[+*{]/.test(query)) {
+    throw new McpError(ErrorCode.InvalidParams, 'Nested quantifiers are not allowed in patterns');
+  }
+  let pattern: RegExp;
+  try {
+    pattern = new RegExp(query, 'i');
+  } catch (error) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Invalid pattern: ${error instanceof Error ? error.message : String(error)}`
+    );
+  }
+  return (text) => pattern.test(text);
+}
+
+/** Completion states a checklist item query can be narrowed to */
+export const CHECK_ITEM_STATES = ['all', 'complete', 'incomplete'] as const;
+export type CheckItemState = (typeof CHECK_ITEM_STATES)[number];
+
+/**
+ * Keep the items in the given state. Stats always describe every item, so
+ * progress stays visible when only the remaining work is returned.
+ */
+export function filterCheckListItems(
+  items: CheckListItem[],
+  state: CheckItemState
+): {
+  items: CheckListItem[];
+  stats: { total: number; complete: number; incomplete: number; percentComplete: number };
+} {
+  const complete = items.filter((item) => item.complete).length;
+  const filtered =
+    state === 'all' ? items : items.filter((item) => item.complete === (state === 'complete'));
+  return {
+    items: filtered,
+    stats: {
+      total: items.length,
+      complete,
+      incomplete: items.length - complete,
+      percentComplete: items.length === 0 ? 0 : Math.round((complete / items.length) * 100),
+    },
+  };
+}
+
 function calculatePercentComplete(items: TrelloCheckItem[]): number {
   if (items.length === 0) return 0;
   const completed = items.filter((item) => item.state === 'complete').length;
@@ -0,0 +1,103 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+import { TrelloMember } from '../types.js';
+
+/**
+ * Prefix comment text with `@username` tokens for the requested members.
+ * Trello only notifies on exact `@username` syntax, so members that can't be
+ * resolved to a username are skipped and reported back instead. Members already
+ * mentioned in the text are not repeated.
+ */
+export function renderMentions(
+  text: string,
+  members: TrelloMember[],
+  mentionMemberIds: string[]
+): { text: string; skipped: Array<{ memberId: string; reason: string }> } {
+  const tokens: string[] = [];
+  const skipped: Array<{ memberId: string; reason: string }> = [];
+
+  for (const memberId of new Set(mentionMemberIds)) {
+    const member = members.find(m => m.id === memberId);
+    if (!member) {
+      skipped.push({ memberId, reason: 'not a member of this board' });
+      continue;
+    }
+    if (!member.username) {
+      skipped.push({ memberId, reason: 'member has no username' });
+      continue;
+    }
+    const token = `@${member.username}`;
+    const alreadyMentioned = new RegExp(`(^|\\s)${escapeRegExp(token)}(?![\\w.-])`).test(text);
+    if (!alreadyMentioned) {
+      tokens.push(token);
+    }
+  }
+
+  return {
+    text: tokens.length > 0 ? `${tokens.join(' ')} ${text}` : text,
+    skipped,
+  };
+}
+
+/** Longest comment Trello accepts, in characters */
+export const MAX_COMMENT_LENGTH = 16384;
+
+/**
+ * Reject comment text Trello would refuse, before it is sent
+ */
+export function assertCommentLength(text: string): void {
+  if (text.length > MAX_COMMENT_LENGTH) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Comment is ${text.length} characters; Trello allows at most ${MAX_COMMENT_LENGTH}. Shorten it or pass autoSplit: true to post it as several comments.`
+    );
+  }
+}
+
+// Room left in each part for the "(i/n) " marker and a closing/reopening code fence
+const PART_OVERHEAD = 32;
+
+/**
+ * Split text into comments of at most maxLength characters, numbered "(1/3) ",
+ * "(2/3) ", ... Breaks fall on paragraph, line or word boundaries where possible,
+ * and a fenced code block cut in two is closed and reopened so each part still
+ * renders as markdown. Text that fits is returned as a single unnumbered part.
+ */
+export function splitComment(text: string, maxLength: number = MAX_COMMENT_LENGTH): string[] {
+  if (text.length <= maxLength) {
+    return [text];
+  }
+  const budget = maxLength - PART_OVERHEAD;
+  const chunks: string[] = [];
+  let rest = text;
+  let reopenFence = false;
+  while (rest.length > 0) {
+    let chunk = rest;
+    let separator = 0;
+    if (rest.length > budget) {
+      const window = rest.slice(0, budget);
+      const minBreak = Math.floor(budget / 2);
+      const breaks: Array<[number, number]> = [
+        [window.lastIndexOf('\n\n'), 2],
+        [window.lastIndexOf('\n'), 1],
+        [window.lastIndexOf(' '), 1],
+      ];
+      const [breakAt, length] = breaks.find(([index]) => index >= minBreak) ?? [budget, 0];
+      chunk = rest.slice(0, breakAt);
+      separator = length;
+    }
+    rest = rest.slice(chunk.length + separator);
+
+    const body = reopenFence ? `\`\`\`\n${chunk}` : chunk;
+    const fenceOpen = (body.match(/^```/gm) ?? []).length % 2 === 1;
+    chunks.push(fenceOpen && rest.length > 0 ? `${body}\n\`\`\`` : body);
+    reopenFence = fenceOpen && rest.length > 0;
+  }
+  // A fence only opens at the start of a line, so it goes below the marker
+  return chunks.map(
+    (chunk, i) => `(${i + 1}/${chunks.length})${chunk.startsWith('```') ? '\n' : ' '}${chunk}`
+  );
+}
+
+function escapeRegExp(value: string): string {
+  return value.replace(/[.*+?^${}()|[\]\\]/g, '\\$&');
+}
@@ -0,0 +1,53 @@
+import { TrelloCustomFieldDefinition, TrelloCustomFieldItem } from '../types.js';
+
+export interface DecodedCustomFieldValue {
+  id: string;
+  name: string;
+  type: TrelloCustomFieldDefinition['type'];
+  value: string | number | boolean | null;
+}
+
+/**
+ * Turn a card's raw custom field items into { name, type, value } using the board's
+ * field definitions. Dropdown values become the option text, dates become ISO strings.
+ * Items whose definition no longer exists on the board are dropped.
+ */
+export function decodeCustomFieldItems(
+  items: TrelloCustomFieldItem[],
+  definitions: TrelloCustomFieldDefinition[]
+): DecodedCustomFieldValue[] {
+  const byId = new Map(definitions.map(def => [def.id, def]));
+  const decoded: DecodedCustomFieldValue[] = [];
+  for (const item of items) {
+    const def = byId.get(item.idCustomField);
+    if (!def) continue;
+    decoded.push({ id: def.id, name: def.name, type: def.type, value: decodeValue(item, def) });
+  }
+  return decoded;
+}
+
+function decodeValue(
+  item: TrelloCustomFieldItem,
+  def: TrelloCustomFieldDefinition
+): DecodedCustomFieldValue['value'] {
+  switch (def.type) {
+    case 'list': {
+      if (!item.idValue) return null;
+      const option = def.options?.find(opt => opt.id === item.idValue);
+      return option ? option.value.text : item.idValue;
+    }
+    case 'text':
+      return item.value?.text ?? null;
+    case 'number':
+      return item.value?.number !== undefined ? Number(item.value.number) : null;
+    case 'checkbox':
+      return item.value?.checked === 'true';
+    case 'date': {
+      if (!item.value?.date) return null;
+      const date = new Date(item.value.date);
+      return isNaN(date.getTime()) ? item.value.date : date.toISOString();
+    }
+    default:
+      return null;
+  }
+}
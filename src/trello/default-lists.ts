@@ -0,0 +1,17 @@
+/**
+ * Validate the `defaultLists` config section (board ID -> list ID)
+ */
+export function parseDefaultLists(section: unknown): Record<string, string> {
+  if (typeof section !== 'object' || section === null || Array.isArray(section)) {
+    throw new Error('defaultLists must be an object mapping board IDs to list IDs');
+  }
+
+  const result: Record<string, string> = {};
+  for (const [boardId, listId] of Object.entries(section)) {
+    if (typeof listId !== 'string' || listId.length === 0) {
+      throw new Error(`defaultLists."${boardId}" must be a list ID string`);
+    }
+    result[boardId] = listId;
+  }
+  return result;
+}
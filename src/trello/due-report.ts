@@ -0,0 +1,106 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+export const DUE_REPORT_BUCKETS = [
+  'overdue',
+  'dueToday',
+  'dueThisWeek',
+  'later',
+  'noDueDate',
+] as const;
+export type DueReportBucket = (typeof DUE_REPORT_BUCKETS)[number];
+
+export interface DueReportCard {
+  id: string;
+  name: string;
+  due: string | null;
+  start: string | null;
+  list: string;
+  url: string;
+}
+
+export interface DueReport {
+  generatedAt: string;
+  timezone: string;
+  buckets: Record<DueReportBucket, { count: number; cards: DueReportCard[] }>;
+}
+
+const DAY_MS = 24 * 60 * 60 * 1000;
+
+/** The calendar date of an instant in a timezone, as YYYY-MM-DD */
+function localDate(instant: Date, timeZone: string): string {
+  return new Intl.DateTimeFormat('en-CA', {
+    timeZone,
+    year: 'numeric',
+    month: '2-digit',
+    day: '2-digit',
+  }).format(instant);
+}
+
+function addDays(date: string, days: number): string {
+  return new Date(Date.parse(`${date}T00:00:00Z`) + days * DAY_MS).toISOString().slice(0, 10);
+}
+
+/**
+ * Sort open, not-yet-complete cards into due-date buckets relative to now in the
+ * given timezone. Overdue means past its due time; "this week" is the six days
+ * after today. Cards marked due-complete are left out. Each card carries its
+ * planned start date, when it has one.
+ */
+export function buildDueReport(
+  cards: Array<{
+    id: string;
+    name: string;
+    due: string | null;
+    start?: string | null;
+    dueComplete: boolean;
+    idList: string;
+    url: string;
+  }>,
+  listNames: Map<string, string>,
+  timeZone: string,
+  now: Date = new Date()
+): DueReport {
+  try {
+    new Intl.DateTimeFormat('en-US', { timeZone });
+  } catch {
+    throw new McpError(ErrorCode.InvalidParams, `Unknown timezone "${timeZone}"`);
+  }
+
+  const today = localDate(now, timeZone);
+  const weekEnd = addDays(today, 6);
+  const buckets = Object.fromEntries(
+    DUE_REPORT_BUCKETS.map(bucket => [bucket, { count: 0, cards: [] as DueReportCard[] }])
+  ) as DueReport['buckets'];
+
+  const sorted = [...cards]
+    .filter(card => !card.dueComplete)
+    .sort((a, b) => {
+      if (a.due && b.due) {
+        return Date.parse(a.due) - Date.parse(b.due) || a.name.localeCompare(b.name);
+      }
+      if (a.due || b.due) return a.due ? -1 : 1;
+      return a.name.localeCompare(b.name);
+    });
+  for (const card of sorted) {
+    let bucket: DueReportBucket;
+    if (!card.due) {
+      bucket = 'noDueDate';
+    } else if (Date.parse(card.due) < now.getTime()) {
+      bucket = 'overdue';
+    } else {
+      const dueDate = localDate(new Date(card.due), timeZone);
+      bucket = dueDate === today ? 'dueToday' : dueDate <= weekEnd ? 'dueThisWeek' : 'later';
+    }
+    buckets[bucket].cards.push({
+      id: card.id,
+      name: card.name,
+      due: card.due,
+      start: card.start ?? null,
+      list: listNames.get(card.idList) ?? card.idList,
+      url: card.url,
+    });
+    buckets[bucket].count++;
+  }
+
+  return { generatedAt: now.toISOString(), timezone: timeZone, buckets };
+}
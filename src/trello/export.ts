@@ -0,0 +1,246 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+import * as path from 'path';
+
+/**
+ * Normalized, deterministic board export. Every collection is sorted by a stable
+ * key and every object has a fixed key order, so exporting an unchanged board
+ * twice yields byte-identical JSON.
+ */
+export const BOARD_EXPORT_VERSION = 1;
+
+export interface BoardExport {
+  version: number;
+  board: { id: string; name: string; desc: string };
+  labels: Array<{ id: string; name: string; color: string | null }>;
+  members: Array<{ id: string; username: string; fullName: string }>;
+  lists: Array<{ id: string; name: string; closed: boolean; pos: number }>;
+  cards: BoardExportCard[];
+}
+
+export interface BoardExportCard {
+  id: string;
+  idList: string;
+  name: string;
+  desc: string;
+  closed: boolean;
+  pos: number;
+  due: string | null;
+  start: string | null;
+  dueComplete: boolean;
+  idLabels: string[];
+  idMembers: string[];
+  checklists: Array<{
+    id: string;
+    name: string;
+    pos: number;
+    items: Array<{
+      id: string;
+      name: string;
+      state: 'complete' | 'incomplete';
+      pos: number;
+      due: string | null;
+      idMember: string | null;
+    }>;
+  }>;
+  attachments: Array<{ id: string; name: string; url: string; isUpload: boolean }>;
+  comments?: Array<{ id: string; date: string; author: string; text: string }>;
+}
+
+/** The nested board payload from GET /boards/{id} that buildBoardExport reads */
+export interface RawBoardPayload {
+  id: string;
+  name: string;
+  desc?: string;
+  labels?: Array<{ id: string; name?: string; color?: string | null }>;
+  members?: Array<{ id: string; username: string; fullName?: string }>;
+  lists?: Array<{ id: string; name: string; closed?: boolean; pos: number }>;
+  cards?: Array<{
+    id: string;
+    idList: string;
+    name: string;
+    desc?: string;
+    closed?: boolean;
+    pos: number;
+    due?: string | null;
+    start?: string | null;
+    dueComplete?: boolean;
+    idLabels?: string[];
+    idMembers?: string[];
+    attachments?: Array<{ id: string; name: string; url: string; isUpload?: boolean }>;
+  }>;
+  checklists?: Array<{
+    id: string;
+    idCard: string;
+    name: string;
+    pos: number;
+    checkItems?: Array<{
+      id: string;
+      name: string;
+      state: 'complete' | 'incomplete';
+      pos: number;
+      due?: string | null;
+      idMember?: string | null;
+    }>;
+  }>;
+  actions?: Array<{
+    id: string;
+    type: string;
+    date: string;
+    data: { text?: string; card?: { id: string } };
+    memberCreator?: { username?: string; fullName?: string };
+  }>;
+}
+
+const byPos = (a: { pos: number; id: string }, b: { pos: number; id: string }) =>
+  a.pos - b.pos || a.id.localeCompare(b.id);
+
+export function buildBoardExport(
+  raw: RawBoardPayload,
+  options: { includeComments: boolean }
+): BoardExport {
+  const lists = [...(raw.lists ?? [])].sort(byPos);
+  const listOrder = new Map(lists.map((list, index) => [list.id, index]));
+
+  const checklistsByCard = new Map<string, NonNullable<RawBoardPayload['checklists']>>();
+  for (const checklist of raw.checklists ?? []) {
+    const forCard = checklistsByCard.get(checklist.idCard) ?? [];
+    forCard.push(checklist);
+    checklistsByCard.set(checklist.idCard, forCard);
+  }
+
+  const commentsByCard = new Map<string, NonNullable<BoardExportCard['comments']>>();
+  if (options.includeComments) {
+    const comments = (raw.actions ?? [])
+      .filter(action => action.type === 'commentCard' && action.data.card)
+      .sort((a, b) => a.date.localeCompare(b.date) || a.id.localeCompare(b.id));
+    for (const action of comments) {
+      const cardId = action.data.card!.id;
+      const forCard = commentsByCard.get(cardId) ?? [];
+      forCard.push({
+        id: action.id,
+        date: action.date,
+        author: action.memberCreator?.username ?? action.memberCreator?.fullName ?? '',
+        text: action.data.text ?? '',
+      });
+      commentsByCard.set(cardId, forCard);
+    }
+  }
+
+  const cards = [...(raw.cards ?? [])]
+    .sort(
+      (a, b) =>
+        (listOrder.get(a.idList) ?? Number.MAX_SAFE_INTEGER) -
+          (listOrder.get(b.idList) ?? Number.MAX_SAFE_INTEGER) || byPos(a, b)
+    )
+    .map(card => {
+      const exported: BoardExportCard = {
+        id: card.id,
+        idList: card.idList,
+        name: card.name,
+        desc: card.desc ?? '',
+        closed: card.closed ?? false,
+        pos: card.pos,
+        due: card.due ?? null,
+        start: card.start ?? null,
+        dueComplete: card.dueComplete ?? false,
+        idLabels: [...(card.idLabels ?? [])].sort(),
+        idMembers: [...(card.idMembers ?? [])].sort(),
+        checklists: (checklistsByCard.get(card.id) ?? []).sort(byPos).map(checklist => ({
+          id: checklist.id,
+          name: checklist.name,
+          pos: checklist.pos,
+          items: [...(checklist.checkItems ?? [])].sort(byPos).map(item => ({
+            id: item.id,
+            name: item.name,
+            state: item.state,
+            pos: item.pos,
+            due: item.due ?? null,
+            idMember: item.idMember ?? null,
+          })),
+        })),
+        attachments: [...(card.attachments ?? [])]
+          .sort((a, b) => a.id.localeCompare(b.id))
+          .map(attachment => ({
+            id: attachment.id,
+            name: attachment.name,
+            url: attachment.url,
+            isUpload: attachment.isUpload ?? false,
+          })),
+      };
+      if (options.includeComments) {
+        exported.comments = commentsByCard.get(card.id) ?? [];
+      }
+      return exported;
+    });
+
+  return {
+    version: BOARD_EXPORT_VERSION,
+    board: { id: raw.id, name: raw.name, desc: raw.desc ?? '' },
+    labels: [...(raw.labels ?? [])]
+      .sort((a, b) => a.id.localeCompare(b.id))
+      .map(label => ({ id: label.id, name: label.name ?? '', color: label.color ?? null })),
+    members: [...(raw.members ?? [])]
+      .sort((a, b) => a.id.localeCompare(b.id))
+      .map(member => ({
+        id: member.id,
+        username: member.username,
+        fullName: member.fullName ?? '',
+      })),
+    lists: lists.map(list => ({
+      id: list.id,
+      name: list.name,
+      closed: list.closed ?? false,
+      pos: list.pos,
+    })),
+    cards,
+  };
+}
+
+/** What import_board_json created, plus anything it could not carry over */
+export interface BoardImportSummary {
+  boardId: string;
+  created: {
+    labels: number;
+    lists: number;
+    cards: number;
+    checklists: number;
+    checkItems: number;
+    attachments: number;
+  };
+  reusedLabels: number;
+  skipped: Array<{ type: string; id: string; name?: string; reason: string }>;
+}
+
+/**
+ * Check that a parsed document looks like a board export this version understands.
+ */
+export function parseBoardExport(value: unknown): BoardExport {
+  const doc = value as Partial<BoardExport> | null;
+  if (
+    !doc ||
+    typeof doc !== 'object' ||
+    !doc.board ||
+    !Array.isArray(doc.lists) ||
+    !Array.isArray(doc.cards)
+  ) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      'Document is not a board export (expected board, lists, and cards)'
+    );
+  }
+  if (doc.version !== BOARD_EXPORT_VERSION) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `Unsupported board export version ${doc.version} (expected ${BOARD_EXPORT_VERSION})`
+    );
+  }
+  return { ...doc, labels: doc.labels ?? [], members: doc.members ?? [] } as BoardExport;
+}
+
+/**
+ * Whether filePath (absolute) is dir itself or somewhere below it
+ */
+export function isWithinDirectory(dir: string, filePath: string): boolean {
+  const relative = path.relative(dir, filePath);
+  return relative !== '..' && !relative.startsWith(`..${path.sep}`) && !path.isAbsolute(relative);
+}
@@ -0,0 +1,32 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+const OBJECT_ID = /^[0-9a-f]{24}$/i;
+const SHORT_LINK = /^[0-9a-z]{8}$/i;
+
+/**
+ * Whether value has the shape of a Trello id: 24 hex characters, or for boards
+ * also the 8-character short link from the board URL.
+ */
+export function isTrelloId(value: string, options: { allowShortLink?: boolean } = {}): boolean {
+  return OBJECT_ID.test(value) || (options.allowShortLink === true && SHORT_LINK.test(value));
+}
+
+/**
+ * Reject a request path whose board or list id cannot be a Trello id, so a typo
+ * fails immediately with a correctable message instead of Trello's bare 400/404.
+ */
+export function assertWellFormedIds(url: string): void {
+  const match = /^\/(boards|lists)\/([^/?#]+)/.exec(url);
+  if (!match) {
+    return;
+  }
+  const [, resource, id] = match;
+  const isBoard = resource === 'boards';
+  if (isTrelloId(id, { allowShortLink: isBoard })) {
+    return;
+  }
+  throw new McpError(
+    ErrorCode.InvalidParams,
+    `${isBoard ? 'Board' : 'List'} id "${id}": that doesn't look like a valid Trello id (expected 24 hex characters${isBoard ? ' or an 8-character short link' : ''}).`
+  );
+}
@@ -0,0 +1,37 @@
+/** Label shapes a caller can ask for: Trello's objects, bare colors, or "color:name" */
+export const LABEL_FORMATS = ['full', 'color', 'color:name'] as const;
+export type LabelFormat = (typeof LABEL_FORMATS)[number];
+
+interface LabelLike {
+  id: string;
+  name?: string | null;
+  color?: string | null;
+}
+
+/**
+ * Render one label. Colorless labels use "none" as their color, and a label with
+ * an empty name is rendered as just its color.
+ */
+export function formatLabel(label: LabelLike, format: LabelFormat): LabelLike | string {
+  const name = label.name?.trim() ?? '';
+  const color = label.color || 'none';
+  switch (format) {
+    case 'color':
+      return color;
+    case 'color:name':
+      return name ? `${color}:${name}` : color;
+    default:
+      return { ...label, name, color: label.color ?? null };
+  }
+}
+
+/**
+ * Apply formatLabel to a card's expanded labels, if it has any
+ */
+export function formatCardLabels<T extends object>(card: T, format: LabelFormat): T {
+  const labels = (card as { labels?: unknown }).labels;
+  if (!Array.isArray(labels)) {
+    return card;
+  }
+  return { ...card, labels: (labels as LabelLike[]).map(label => formatLabel(label, format)) };
+}
@@ -0,0 +1,27 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+const SHORT_LINK_PATTERN = /^[A-Za-z0-9]{8}$/;
+const CARD_ID_PATTERN = /^[0-9a-f]{24}$/;
+
+/**
+ * Extract a card short link from a Trello card URL or bare code.
+ * Accepts forms like `https://trello.com/c/AbCdEf12/42-some-slug?filter=x#comment`,
+ * `trello.com/c/AbCdEf12`, `AbCdEf12`, or a full 24-character card ID.
+ */
+export function parseCardShortLink(input: string): string {
+  const trimmed = input.trim();
+  if (SHORT_LINK_PATTERN.test(trimmed) || CARD_ID_PATTERN.test(trimmed)) {
+    return trimmed;
+  }
+
+  const withoutQuery = trimmed.split(/[?#]/)[0];
+  const match = withoutQuery.match(/(?:^|\/)c\/([A-Za-z0-9]+)(?:\/|$)/);
+  if (match && (SHORT_LINK_PATTERN.test(match[1]) || CARD_ID_PATTERN.test(match[1]))) {
+    return match[1];
+  }
+
+  throw new McpError(
+    ErrorCode.InvalidParams,
+    `Could not find a Trello card short link in "${input}". Expected a URL like https://trello.com/c/AbCdEf12/... or an 8-character short link.`
+  );
+}
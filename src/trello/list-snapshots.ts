@@ -0,0 +1,75 @@
+/** What snapshot_list remembers about each card in a list */
+export interface ListSnapshot {
+  listId: string;
+  takenAt: string;
+  cards: Record<string, { name: string; dateLastActivity: string; pos: number }>;
+}
+
+export interface ListDiff {
+  listId: string;
+  since: string;
+  added: Array<{ id: string; name: string }>;
+  /** Cards no longer in the list, with where they went once resolved */
+  removed: Array<{
+    id: string;
+    name: string;
+    status?: 'moved' | 'archived' | 'deleted';
+    idList?: string;
+  }>;
+  /** Cards still in the list whose activity date changed */
+  updated: Array<{ id: string; name: string; dateLastActivity: string }>;
+  /** Cards still in the list that changed position without other activity */
+  reordered: Array<{ id: string; name: string }>;
+}
+
+export function takeListSnapshot(
+  listId: string,
+  cards: Array<{ id: string; name: string; dateLastActivity: string; pos: number }>,
+  now: Date = new Date()
+): ListSnapshot {
+  return {
+    listId,
+    takenAt: now.toISOString(),
+    cards: Object.fromEntries(
+      cards.map(card => [
+        card.id,
+        { name: card.name, dateLastActivity: card.dateLastActivity, pos: card.pos },
+      ])
+    ),
+  };
+}
+
+/**
+ * Compare a list's current cards with an earlier snapshot of the same list
+ */
+export function diffListSnapshot(
+  snapshot: ListSnapshot,
+  cards: Array<{ id: string; name: string; dateLastActivity: string; pos: number }>
+): ListDiff {
+  const diff: ListDiff = {
+    listId: snapshot.listId,
+    since: snapshot.takenAt,
+    added: [],
+    removed: [],
+    updated: [],
+    reordered: [],
+  };
+  const current = new Set<string>();
+  for (const card of cards) {
+    current.add(card.id);
+    const before = snapshot.cards[card.id];
+    if (!before) {
+      diff.added.push({ id: card.id, name: card.name });
+    } else if (before.dateLastActivity !== card.dateLastActivity) {
+      diff.updated.push({ id: card.id, name: card.name, dateLastActivity: card.dateLastActivity });
+    } else if (before.pos !== card.pos) {
+      diff.reordered.push({ id: card.id, name: card.name });
+    }
+  }
+  for (const [id, before] of Object.entries(snapshot.cards)) {
+    if (!current.has(id)) {
+      diff.removed.push({ id, name: before.name });
+    }
+  }
+  return diff;
+}
@@ -0,0 +1,82 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+/**
+ * How name-based lookups compare names: "exact" is case-sensitive, "ci" ignores
+ * case, and "fuzzy" also ignores punctuation and spacing and accepts partial
+ * names, falling back to it only when nothing matches case-insensitively.
+ */
+export const NAME_MATCHING_MODES = ['exact', 'ci', 'fuzzy'] as const;
+export type NameMatching = (typeof NAME_MATCHING_MODES)[number];
+
+export const DEFAULT_NAME_MATCHING: NameMatching = 'ci';
+
+function normalize(name: string): string {
+  return name
+    .toLowerCase()
+    .replace(/[^\p{L}\p{N}]+/gu, ' ')
+    .trim();
+}
+
+/**
+ * Items with a name matching query. names returns every name an item goes by
+ * (e.g. a workspace's display name and short name).
+ */
+export function filterByName<T>(
+  items: T[],
+  query: string,
+  names: (item: T) => Array<string | null | undefined>,
+  mode: NameMatching = DEFAULT_NAME_MATCHING
+): T[] {
+  const needle = query.trim();
+  const anyName = (item: T, test: (name: string) => boolean) =>
+    names(item).some(name => typeof name === 'string' && test(name));
+
+  if (mode === 'exact') {
+    return items.filter(item => anyName(item, name => name.trim() === needle));
+  }
+  const lower = needle.toLowerCase();
+  const caseInsensitive = items.filter(item =>
+    anyName(item, name => name.trim().toLowerCase() === lower)
+  );
+  if (mode === 'ci' || caseInsensitive.length > 0) {
+    return caseInsensitive;
+  }
+  const fuzzy = normalize(needle);
+  if (!fuzzy) {
+    return [];
+  }
+  return items.filter(item => anyName(item, name => normalize(name).includes(fuzzy)));
+}
+
+/**
+ * Resolve query to exactly one item. Every lookup reports failures the same way:
+ * `<Kind> "<query>" not found. <hint>` when nothing matches, and
+ * `<Kind> name "<query>" is ambiguous: <name> (<id>), ... Pass <idParam> instead.`
+ * when several do.
+ */
+export function resolveByName<T extends { id: string }>(
+  items: T[],
+  query: string,
+  options: {
+    kind: string;
+    names: (item: T) => Array<string | null | undefined>;
+    mode?: NameMatching;
+    idParam: string;
+    notFoundHint?: string;
+  }
+): T {
+  const matches = filterByName(items, query, options.names, options.mode);
+  if (matches.length === 0) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `${options.kind} "${query}" not found.${options.notFoundHint ? ` ${options.notFoundHint}` : ''}`
+    );
+  }
+  if (matches.length > 1) {
+    throw new McpError(
+      ErrorCode.InvalidParams,
+      `${options.kind} name "${query}" is ambiguous: ${matches.map(item => `${options.names(item)[0]} (${item.id})`).join(', ')}. Pass ${options.idParam} instead.`
+    );
+  }
+  return matches[0];
+}
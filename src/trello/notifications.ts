@@ -0,0 +1,27 @@
+import { TrelloNotification } from '../types.js';
+
+export interface NotificationSummary {
+  id: string;
+  type: string;
+  date: string;
+  card: { id: string; name: string | null } | null;
+  board: { id: string; name: string | null } | null;
+  unread: boolean;
+}
+
+/**
+ * Flatten a notification to the card and board it is about. Notifications that
+ * are not tied to a card or board (e.g. workspace invites) get null there.
+ */
+export function summarizeNotification(notification: TrelloNotification): NotificationSummary {
+  const card = notification.data?.card;
+  const board = notification.data?.board;
+  return {
+    id: notification.id,
+    type: notification.type,
+    date: notification.date,
+    card: card ? { id: card.id, name: card.name ?? null } : null,
+    board: board ? { id: board.id, name: board.name ?? null } : null,
+    unread: notification.unread,
+  };
+}
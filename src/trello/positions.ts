@@ -0,0 +1,60 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+/** Gap Trello leaves between consecutive positions */
+export const POSITION_STEP = 65536;
+
+/**
+ * Compute a `pos` that places an item directly above or below a reference
+ * item, using the midpoint between the reference and its neighbor. `items`
+ * are the other items in the target container; the item being moved should
+ * already be excluded.
+ */
+export function positionRelativeTo(
+  items: Array<{ id: string; pos: number }>,
+  referenceId: string,
+  placement: 'above' | 'below'
+): number {
+  const sorted = [...items].sort((a, b) => a.pos - b.pos);
+  const index = sorted.findIndex(item => item.id === referenceId);
+  if (index === -1) {
+    throw new McpError(ErrorCode.InvalidParams, `Reference item ${referenceId} not found`);
+  }
+
+  const reference = sorted[index];
+  if (placement === 'above') {
+    const previous = sorted[index - 1];
+    return previous ? (previous.pos + reference.pos) / 2 : reference.pos / 2;
+  }
+  const next = sorted[index + 1];
+  return next ? (reference.pos + next.pos) / 2 : reference.pos + POSITION_STEP;
+}
+
+/** Keys cards can be sorted by */
+export const CARD_SORT_KEYS = ['pos', 'due', 'name', 'dateLastActivity'] as const;
+export type CardSortKey = (typeof CARD_SORT_KEYS)[number];
+
+/**
+ * Sort cards by a key. Cards without a due date go last in either order, and
+ * ties keep their current relative position.
+ */
+export function sortCards<
+  T extends { name: string; due: string | null; dateLastActivity: string; pos: number },
+>(cards: T[], sortBy: CardSortKey, order: 'asc' | 'desc'): T[] {
+  const direction = order === 'asc' ? 1 : -1;
+  const compareKey = (a: T, b: T): number => {
+    switch (sortBy) {
+      case 'pos':
+        return direction * (a.pos - b.pos);
+      case 'name':
+        return direction * a.name.localeCompare(b.name, undefined, { sensitivity: 'base' });
+      case 'dateLastActivity':
+        return direction * (Date.parse(a.dateLastActivity) - Date.parse(b.dateLastActivity));
+      case 'due':
+        if (!a.due || !b.due) {
+          return (a.due ? 0 : 1) - (b.due ? 0 : 1);
+        }
+        return direction * (Date.parse(a.due) - Date.parse(b.due));
+    }
+  };
+  return [...cards].sort((a, b) => compareKey(a, b) || a.pos - b.pos);
+}
@@ -0,0 +1,25 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+
+/** Plugin IDs of the built-in Power-Ups other tools depend on */
+export const WELL_KNOWN_POWER_UPS: Readonly<Record<string, string>> = Object.freeze({
+  'custom-fields': '56d5e249a98895a9797bebb9',
+  voting: '55a5d917446f517774210011',
+  calendar: '55a5d916446f517774210004',
+});
+
+/**
+ * Accept either a plugin ID or one of the WELL_KNOWN_POWER_UPS names
+ */
+export function resolvePowerUpId(powerUp: string): string {
+  const known = WELL_KNOWN_POWER_UPS[powerUp.trim().toLowerCase()];
+  if (known) {
+    return known;
+  }
+  if (/^[0-9a-f]{24}$/i.test(powerUp)) {
+    return powerUp;
+  }
+  throw new McpError(
+    ErrorCode.InvalidParams,
+    `Unknown Power-Up "${powerUp}". Use a plugin ID or one of: ${Object.keys(WELL_KNOWN_POWER_UPS).join(', ')}`
+  );
+}
@@ -0,0 +1,37 @@
+import { DEFAULT_NAME_MATCHING, filterByName, NameMatching } from './name-matching.js';
+
+/**
+ * Validate the `wipLimits` config section (list name -> maximum open cards)
+ */
+export function parseWipLimits(section: unknown): Record<string, number> {
+  if (typeof section !== 'object' || section === null || Array.isArray(section)) {
+    throw new Error('wipLimits must be an object mapping list names to card limits');
+  }
+
+  const result: Record<string, number> = {};
+  for (const [listName, value] of Object.entries(section)) {
+    if (typeof value !== 'number' || !Number.isInteger(value) || value < 0) {
+      throw new Error(`wipLimits."${listName}" must be a non-negative integer`);
+    }
+    result[listName] = value;
+  }
+  return result;
+}
+
+/**
+ * The limit configured for a list, comparing names per nameMatching. When several
+ * configured names match, the strictest limit applies.
+ */
+export function findWipLimit(
+  limits: Record<string, number>,
+  listName: string,
+  nameMatching: NameMatching = DEFAULT_NAME_MATCHING
+): number | undefined {
+  const matches = filterByName(
+    Object.entries(limits),
+    listName,
+    ([name]) => [name],
+    nameMatching
+  );
+  return matches.length > 0 ? Math.min(...matches.map(([, limit]) => limit)) : undefined;
+}
@@ -6,6 +6,31 @@ export interface TrelloConfig {
   workspaceId?: string;
   /** Optional list of workspace IDs to restrict access to. If set, only these workspaces can be accessed. */
   allowedWorkspaceIds?: string[];
+  /** Maximum number of retries for rate-limited (429) requests. */
+  maxRetries?: number;
+  /** Base delay for exponential backoff between retries, in milliseconds. */
+  baseDelayMs?: number;
+  /** Upper bound on a single backoff delay, in milliseconds. */
+  maxDelayMs?: number;
+  /** Per-request timeout, in milliseconds. Timed-out requests are retried like 429s. */
+  timeoutMs?: number;
+  /** Directory export_board_json writes large exports to. Defaults to ~/.trello-mcp/exports. */
+  exportDir?: string;
+  /** Largest attachment get_attachment_content will return, in bytes. */
+  maxAttachmentBytes?: number;
+  /** How name-based lookups compare names: "exact", "ci" (default), or "fuzzy". */
+  nameMatching?: 'exact' | 'ci' | 'fuzzy';
+}
+
+export interface TrelloClientStats {
+  requests: number;
+  retries: number;
+  retriesExhausted: number;
+  timeouts: number;
+  failures: number;
+  /** GETs answered 304 Not Modified and served from the ETag cache */
+  cacheHits: number;
+  lastRetryAt: string | null;
 }
 
 export interface TrelloBoard {
@@ -16,6 +41,25 @@ export interface TrelloBoard {
   idOrganization: string;
   url: string;
   shortUrl: string;
+  prefs?: TrelloBoardPrefs;
+}
+
+export interface TrelloBoardPrefs {
+  permissionLevel: 'org' | 'private' | 'public';
+  voting: 'disabled' | 'members' | 'observers' | 'org' | 'public';
+  comments: 'disabled' | 'members' | 'observers' | 'org' | 'public';
+  background: string;
+  backgroundColor?: string | null;
+  backgroundImage?: string | null;
+  cardCovers: boolean;
+  isTemplate?: boolean;
+  [key: string]: unknown;
+}
+
+export interface TrelloBoardPlugin {
+  id: string;
+  idBoard: string;
+  idPlugin: string;
 }
 
 export interface TrelloWorkspace {
@@ -32,11 +76,17 @@ export interface TrelloCard {
   name: string;
   desc: string;
   due: string | null;
+  dueComplete: boolean;
   idList: string;
+  idBoard: string;
   idLabels: string[];
+  idMembers: string[];
   closed: boolean;
   url: string;
   dateLastActivity: string;
+  pos: number;
+  start?: string | null;
+  subscribed?: boolean;
 }
 
 export interface TrelloList {
@@ -57,11 +107,29 @@ export interface TrelloAction {
     card?: {
       id: string;
       name: string;
+      due?: string | null;
+      dueComplete?: boolean;
+      closed?: boolean;
     };
     list?: {
       id: string;
       name: string;
     };
+    listBefore?: {
+      id: string;
+      name: string;
+    };
+    listAfter?: {
+      id: string;
+      name: string;
+    };
+    label?: {
+      id: string;
+      name: string;
+      color: string;
+    };
+    idMember?: string;
+    old?: Record<string, unknown>;
     board: {
       id: string;
       name: string;
@@ -72,6 +140,30 @@ export interface TrelloAction {
     fullName: string;
     username: string;
   };
+  member?: {
+    id: string;
+    fullName: string;
+    username: string;
+  };
+}
+
+/** One emoji's tally from GET /actions/{id}/reactionsSummary, flattened */
+export interface TrelloReactionSummary {
+  emoji: string;
+  native: string | null;
+  count: number;
+}
+
+export interface TrelloNotification {
+  id: string;
+  type: string;
+  date: string;
+  unread: boolean;
+  data?: {
+    card?: { id: string; name?: string };
+    board?: { id: string; name?: string };
+    [key: string]: unknown;
+  };
 }
 
 export interface TrelloLabel {
@@ -87,6 +179,13 @@ export interface TrelloMember {
   avatarUrl: string | null;
 }
 
+export interface TrelloAuthenticatedMember {
+  id: string;
+  username: string;
+  fullName: string;
+  email: string | null;
+}
+
 export interface TrelloAttachment {
   id: string;
   name: string;
@@ -138,6 +237,15 @@ export interface TrelloLabelDetails {
   color: string;
 }
 
+/** Outcome for one card of a bulk label application */
+export interface CardLabelResult {
+  cardId: string;
+  success: boolean;
+  status?: 'added' | 'skipped';
+  reason?: string;
+  error?: string;
+}
+
 export interface TrelloComment {
   id: string;
   date: string;
@@ -287,3 +395,8 @@ export interface CheckListItem {
   complete: boolean;
   parentCheckListId: string;
 }
+
+export interface CheckListItemMatch extends CheckListItem {
+  checklistName: string;
+  cardId: string;
+}
@@ -0,0 +1,85 @@
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+import type { McpServer } from '@modelcontextprotocol/sdk/server/mcp.js';
+
+/** The subset of a Zod v4 issue the formatter reads */
+export interface ValidationIssue {
+  code: string;
+  path: PropertyKey[];
+  message: string;
+  expected?: string;
+  values?: unknown[];
+}
+
+/**
+ * Render a path like ['memberIds', 1, 'id'] as "memberIds[1].id"
+ */
+export function formatIssuePath(path: PropertyKey[]): string {
+  if (path.length === 0) {
+    return 'arguments';
+  }
+  return path
+    .map((segment, index) => {
+      if (typeof segment === 'number') return `[${segment}]`;
+      return index === 0 ? String(segment) : `.${String(segment)}`;
+    })
+    .join('');
+}
+
+/**
+ * One line per issue, e.g. "memberIds[1]: expected string"
+ */
+export function formatZodIssues(issues: ValidationIssue[]): string {
+  return issues
+    .map(issue => {
+      let detail = issue.message;
+      if (issue.code === 'invalid_type' && issue.expected) {
+        detail = `expected ${issue.expected}`;
+      } else if (issue.code === 'invalid_value' && issue.values) {
+        detail = `expected one of ${issue.values.map(value => JSON.stringify(value)).join(', ')}`;
+      }
+      return `${formatIssuePath(issue.path)}: ${detail}`;
+    })
+    .join('\n');
+}
+
+type ParsableSchema = {
+  safeParseAsync(data: unknown): Promise<{ success: boolean; error?: { issues: ValidationIssue[] } }>;
+};
+
+type ToolInputValidator = (
+  tool: { inputSchema?: unknown },
+  args: unknown,
+  toolName: string
+) => Promise<unknown>;
+
+/**
+ * McpServer rejects bad tool arguments with the raw ZodError JSON. Re-parse failed
+ * input here so the caller gets one "path: problem" line per issue instead.
+ * The SDK has no public hook for this, so its private validateToolInput is wrapped;
+ * tests/unit/validation.test.ts fails if an SDK upgrade removes or bypasses it.
+ */
+export function installValidationErrorFormatter(server: McpServer): void {
+  const target = server as unknown as { validateToolInput?: ToolInputValidator };
+  const original = target.validateToolInput?.bind(server);
+  if (!original) {
+    return;
+  }
+  target.validateToolInput = async (tool, args, toolName) => {
+    try {
+      return await original(tool, args, toolName);
+    } catch (error) {
+      const schema = tool.inputSchema as Partial<ParsableSchema> | undefined;
+      if (!(error instanceof McpError) || typeof schema?.safeParseAsync !== 'function') {
+        throw error;
+      }
+      const result = await schema.safeParseAsync(args ?? {});
+      if (result.success || !result.error) {
+        throw error;
+      }
+      throw new McpError(
+        ErrorCode.InvalidParams,
+        `Invalid arguments for tool ${toolName}:\n${formatZodIssues(result.error.issues)}`
+      );
+    }
+  };
+}
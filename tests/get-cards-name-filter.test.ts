@@ -142,3 +142,68 @@ describe('getCardsByList nameFilter', () => {
     });
   });
 });
+
+describe('getCardsByList label filter', () => {
+  const RED_1 = 'aaaaaaaaaaaaaaaaaaaaaaa1';
+  const RED_2 = 'aaaaaaaaaaaaaaaaaaaaaaa2';
+  const BLUE = 'bbbbbbbbbbbbbbbbbbbbbbb1';
+  const LABELED_CARDS = [
+    { ...MOCK_CARDS[0], idLabels: [RED_1] },
+    { ...MOCK_CARDS[1], idLabels: [RED_2, BLUE] },
+    { ...MOCK_CARDS[2], idLabels: [BLUE] },
+    { ...MOCK_CARDS[3], idLabels: [] },
+  ];
+
+  function createLabeledClient() {
+    const client = new TrelloClient({ apiKey: 'fake', token: 'fake', boardId: 'board1' });
+    (client as any).axiosInstance = {
+      get: vi.fn((url: string) => {
+        if (url === '/lists/list1') return Promise.resolve({ data: { id: 'list1', idBoard: 'board1' } });
+        if (url === '/boards/board1/labels') {
+          return Promise.resolve({
+            data: [
+              { id: RED_1, color: 'red' },
+              { id: RED_2, color: 'red' },
+              { id: BLUE, color: 'blue' },
+            ],
+          });
+        }
+        return Promise.resolve({ data: LABELED_CARDS });
+      }),
+    };
+    return client;
+  }
+
+  it('matches every label of a named color', async () => {
+    const client = createLabeledClient();
+    const cards = await client.getCardsByList('list1', undefined, undefined, { labels: ['red'] });
+    expect(cards.map(card => card.id)).toEqual(['1', '2']);
+    expect((client as any).axiosInstance.get).toHaveBeenCalledWith('/boards/board1/labels');
+  });
+
+  it('requires every entry in all mode', async () => {
+    const client = createLabeledClient();
+    const cards = await client.getCardsByList('list1', undefined, undefined, {
+      labels: ['red', BLUE],
+      mode: 'all',
+    });
+    expect(cards.map(card => card.id)).toEqual(['2']);
+  });
+
+  it('filters by label IDs without fetching board labels and keeps idLabels in fields', async () => {
+    const client = createLabeledClient();
+    const cards = await client.getCardsByList('list1', 'name', undefined, { labels: [BLUE] });
+    expect(cards.map(card => card.id)).toEqual(['2', '3']);
+    expect((client as any).axiosInstance.get).toHaveBeenCalledTimes(1);
+    expect((client as any).axiosInstance.get).toHaveBeenCalledWith('/lists/list1/cards', {
+      params: { fields: 'name,idLabels' },
+    });
+  });
+
+  it('rejects a color with no matching label', async () => {
+    const client = createLabeledClient();
+    await expect(
+      client.getCardsByList('list1', undefined, undefined, { labels: ['purple'] })
+    ).rejects.toThrow('No label with color "purple" on this board');
+  });
+});
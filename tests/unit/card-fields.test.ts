@@ -0,0 +1,30 @@
+import { describe, it, expect } from 'vitest';
+import { parseDefaultFields } from '../../src/card-fields.js';
+
+describe('parseDefaultFields', () => {
+  it('normalizes string and array values to comma-separated fields', () => {
+    expect(
+      parseDefaultFields({
+        get_cards_by_list_id: ' name, due ,labels',
+        get_my_cards: ['name', 'idList'],
+      })
+    ).toEqual({
+      get_cards_by_list_id: 'name,due,labels',
+      get_my_cards: 'name,idList',
+    });
+  });
+
+  it('drops tools with no fields', () => {
+    expect(parseDefaultFields({ get_cards_by_list_id: '' })).toEqual({});
+  });
+
+  it('rejects unknown card fields', () => {
+    expect(() => parseDefaultFields({ get_cards_by_list_id: 'name,dueDate' })).toThrow(
+      'defaultFields.get_cards_by_list_id contains unknown card fields: dueDate'
+    );
+  });
+
+  it('rejects a non-object section', () => {
+    expect(() => parseDefaultFields(['name'])).toThrow('defaultFields must be an object');
+  });
+});
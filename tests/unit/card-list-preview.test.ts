@@ -1,5 +1,5 @@
 import { describe, expect, it } from 'vitest';
-import { formatCardListResponse } from '../../src/card-list-preview.js';
+import { formatCardListResponse, truncateDescription } from '../../src/card-list-preview.js';
 import type { TrelloCard } from '../../src/types.js';
 
 function card(overrides: Partial<TrelloCard> = {}): TrelloCard {
@@ -49,3 +49,20 @@ describe('formatCardListResponse', () => {
     expect(response.content[1].text).toContain('Descriptions omitted');
   });
 });
+
+describe('truncateDescription', () => {
+  it('cuts long descriptions and flags the card', () => {
+    expect(truncateDescription(card({ desc: 'abcdefgh' }), 6)).toMatchObject({
+      desc: 'abc...',
+      descTruncated: true,
+    });
+  });
+
+  it('leaves short descriptions and an unset limit alone', () => {
+    const short = card({ desc: 'abc' });
+    expect(truncateDescription(short, 3)).toBe(short);
+    expect(truncateDescription(card({ desc: 'x'.repeat(5000) }), undefined)).not.toHaveProperty(
+      'descTruncated'
+    );
+  });
+});
@@ -0,0 +1,50 @@
+import { describe, it, expect } from 'vitest';
+import { mapWithConcurrency, partitionSettled } from '../../src/concurrency.js';
+
+describe('mapWithConcurrency', () => {
+  it('never runs more than the limit at once', async () => {
+    let active = 0;
+    let peak = 0;
+    await mapWithConcurrency([1, 2, 3, 4, 5, 6, 7], 3, async () => {
+      active++;
+      peak = Math.max(peak, active);
+      await new Promise(resolve => setTimeout(resolve, 5));
+      active--;
+    });
+
+    expect(peak).toBe(3);
+  });
+
+  it('preserves order and isolates failures', async () => {
+    const results = await mapWithConcurrency(['a', 'b', 'c'], 2, async item => {
+      if (item === 'b') throw new Error('boom');
+      return item.toUpperCase();
+    });
+
+    expect(results[0]).toEqual({ status: 'fulfilled', value: 'A' });
+    expect(results[1].status).toBe('rejected');
+    expect(results[2]).toEqual({ status: 'fulfilled', value: 'C' });
+  });
+
+  it('handles an empty list', async () => {
+    expect(await mapWithConcurrency([], 4, async () => 1)).toEqual([]);
+  });
+});
+
+describe('partitionSettled', () => {
+  it('pairs successes and failures with their items and indexes', () => {
+    const settled: PromiseSettledResult<string>[] = [
+      { status: 'fulfilled', value: 'A' },
+      { status: 'rejected', reason: new Error('boom') },
+      { status: 'rejected', reason: 'not an error' },
+    ];
+
+    expect(partitionSettled(['a', 'b', 'c'], settled)).toEqual({
+      fulfilled: [{ item: 'a', index: 0, value: 'A' }],
+      rejected: [
+        { item: 'b', index: 1, error: 'boom' },
+        { item: 'c', index: 2, error: 'Unknown error' },
+      ],
+    });
+  });
+});
@@ -0,0 +1,88 @@
+import { describe, it, expect } from 'vitest';
+import type { McpServer } from '@modelcontextprotocol/sdk/server/mcp.js';
+import {
+  compactValue,
+  installResponseShaping,
+  isReadTool,
+  OutputVerbosity,
+  shapeToolResult,
+} from '../../src/output-shaping.js';
+
+const text = (value: unknown) => ({
+  content: [{ type: 'text' as const, text: JSON.stringify(value, null, 2) }],
+});
+
+describe('isReadTool', () => {
+  it('recognizes read tools by name', () => {
+    expect(isReadTool('get_card')).toBe(true);
+    expect(isReadTool('list_boards')).toBe(true);
+    expect(isReadTool('whoami')).toBe(true);
+    expect(isReadTool('update_card')).toBe(false);
+  });
+});
+
+describe('compactValue', () => {
+  it('drops nulls, empty arrays and non-essential fields at any depth', () => {
+    expect(
+      compactValue({
+        id: 'c1',
+        due: null,
+        idMembers: [],
+        desc: '',
+        badges: { comments: 2 },
+        labels: [{ id: 'l1', name: 'Bug', color: null }],
+      })
+    ).toEqual({ id: 'c1', desc: '', labels: [{ id: 'l1', name: 'Bug' }] });
+  });
+
+  it('keeps array elements in place', () => {
+    expect(compactValue([null, { id: 'a' }])).toEqual([null, { id: 'a' }]);
+  });
+});
+
+describe('shapeToolResult', () => {
+  it('returns full results and errors untouched', () => {
+    const result = text({ id: 'c1', due: null });
+    expect(shapeToolResult(result, 'full')).toBe(result);
+    const error = { content: [{ type: 'text', text: 'Error: nope' }], isError: true };
+    expect(shapeToolResult(error, 'compact')).toBe(error);
+  });
+
+  it('compacts JSON text and leaves other text alone', () => {
+    expect(shapeToolResult(text({ id: 'c1', due: null }), 'compact').content[0].text).toBe(
+      '{"id":"c1"}'
+    );
+    const plain = { content: [{ type: 'text', text: 'Moved 3 cards' }] };
+    expect(shapeToolResult(plain, 'compact')).toEqual(plain);
+  });
+});
+
+describe('installResponseShaping', () => {
+  function fakeServer() {
+    const handlers = new Map<string, (...args: unknown[]) => Promise<unknown>>();
+    return {
+      handlers,
+      registerTool: (
+        name: string,
+        _config: unknown,
+        handler: (...args: unknown[]) => Promise<unknown>
+      ) => {
+        handlers.set(name, handler);
+      },
+    };
+  }
+
+  it('shapes read tools with the verbosity in effect when they run', async () => {
+    const server = fakeServer();
+    let verbosity: OutputVerbosity = 'full';
+    installResponseShaping(server as unknown as McpServer, () => verbosity);
+    server.registerTool('get_card', {}, async () => text({ id: 'c1', due: null }));
+    server.registerTool('update_card', {}, async () => text({ id: 'c1', due: null }));
+
+    verbosity = 'compact';
+    expect(await server.handlers.get('get_card')!({})).toEqual({
+      content: [{ type: 'text', text: '{"id":"c1"}' }],
+    });
+    expect(await server.handlers.get('update_card')!({})).toEqual(text({ id: 'c1', due: null }));
+  });
+});
@@ -0,0 +1,41 @@
+import { describe, it, expect } from 'vitest';
+import { decodeCursor, encodeCursor, fetchPage } from '../../src/pagination.js';
+
+// Newest first, like Trello action endpoints
+const ACTIONS = ['a7', 'a6', 'a5', 'a4', 'a3', 'a2', 'a1'].map(id => ({ id }));
+
+async function fetchActions(limit: number, before?: string) {
+  const start = before ? ACTIONS.findIndex(action => action.id === before) + 1 : 0;
+  return ACTIONS.slice(start, start + limit);
+}
+
+describe('pagination', () => {
+  it('round-trips a cursor', () => {
+    expect(decodeCursor(encodeCursor({ before: 'a3' }))).toEqual({ before: 'a3' });
+  });
+
+  it('rejects malformed cursors', () => {
+    expect(() => decodeCursor('not-a-cursor')).toThrow('Invalid cursor');
+    expect(() => decodeCursor(encodeCursor({ before: '' }))).toThrow('Invalid cursor');
+  });
+
+  it('walks pages without duplicates or gaps', async () => {
+    const seen: string[] = [];
+    let cursor: string | undefined;
+    let pages = 0;
+    do {
+      const page = await fetchPage(3, cursor, before => fetchActions(3, before));
+      seen.push(...page.items.map(item => item.id));
+      cursor = page.nextCursor ?? undefined;
+      pages++;
+    } while (cursor);
+
+    expect(pages).toBe(3);
+    expect(seen).toEqual(ACTIONS.map(action => action.id));
+  });
+
+  it('returns no cursor for a short page or when no limit is set', async () => {
+    expect((await fetchPage(10, undefined, () => fetchActions(10))).nextCursor).toBeNull();
+    expect((await fetchPage(undefined, undefined, () => fetchActions(100))).nextCursor).toBeNull();
+  });
+});
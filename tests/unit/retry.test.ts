@@ -0,0 +1,35 @@
+import { describe, it, expect } from 'vitest';
+import { computeBackoffDelay, DEFAULT_RETRY_OPTIONS } from '../../src/retry.js';
+
+describe('computeBackoffDelay', () => {
+  const options = { baseDelayMs: 100, maxDelayMs: 1000 };
+
+  it('stays within the upper half of the exponential step', () => {
+    for (let attempt = 0; attempt < 3; attempt++) {
+      const step = 100 * Math.pow(2, attempt);
+      for (let i = 0; i < 50; i++) {
+        const delay = computeBackoffDelay(attempt, options);
+        expect(delay).toBeGreaterThanOrEqual(step / 2);
+        expect(delay).toBeLessThanOrEqual(step);
+      }
+    }
+  });
+
+  it('maps the random draw onto the jitter window', () => {
+    expect(computeBackoffDelay(1, options, () => 0)).toBe(100);
+    expect(computeBackoffDelay(1, options, () => 0.5)).toBe(150);
+    expect(computeBackoffDelay(1, options, () => 1)).toBe(200);
+  });
+
+  it('never exceeds maxDelayMs for late attempts', () => {
+    for (let i = 0; i < 50; i++) {
+      const delay = computeBackoffDelay(10, options);
+      expect(delay).toBeGreaterThanOrEqual(500);
+      expect(delay).toBeLessThanOrEqual(1000);
+    }
+  });
+
+  it('uses the documented defaults', () => {
+    expect(DEFAULT_RETRY_OPTIONS).toEqual({ maxRetries: 3, baseDelayMs: 1000, maxDelayMs: 30000 });
+  });
+});
@@ -0,0 +1,88 @@
+import { describe, it, expect, beforeAll, afterAll } from 'vitest';
+import http from 'http';
+import { AddressInfo } from 'net';
+import { TrelloClient } from '../../src/trello-client.js';
+import { McpError } from '@modelcontextprotocol/sdk/types.js';
+import { TrelloTimeoutError } from '../../src/errors.js';
+
+// Uses real axios against a local server that never answers, so the request
+// interceptors run exactly as they do in production
+describe('request timeout', () => {
+  const boardId = '5f1b2c3d4e5f6a7b8c9d0e1f';
+  let server: http.Server;
+  let baseURL: string;
+  let requestsReceived = 0;
+  const savedProxy = { https_proxy: process.env.https_proxy, HTTPS_PROXY: process.env.HTTPS_PROXY };
+
+  beforeAll(async () => {
+    delete process.env.https_proxy;
+    delete process.env.HTTPS_PROXY;
+    server = http.createServer(() => {
+      requestsReceived++;
+      // Never respond
+    });
+    await new Promise<void>(resolve => server.listen(0, '127.0.0.1', resolve));
+    baseURL = `http://127.0.0.1:${(server.address() as AddressInfo).port}`;
+  });
+
+  afterAll(async () => {
+    server.closeAllConnections();
+    await new Promise(resolve => server.close(resolve));
+    Object.assign(process.env, savedProxy);
+  });
+
+  it('aborts at the configured timeout and retries per policy', async () => {
+    const client = new TrelloClient({
+      apiKey: 'test-key',
+      token: 'test-token',
+      timeoutMs: 100,
+      maxRetries: 1,
+      baseDelayMs: 0,
+      maxDelayMs: 0,
+    });
+    (client as any).axiosInstance.defaults.baseURL = baseURL;
+
+    const started = Date.now();
+    const error = await client.getBoardById(boardId).catch(err => err);
+    const elapsed = Date.now() - started;
+
+    expect(error).toBeInstanceOf(TrelloTimeoutError);
+    expect(error.message).toContain('timed out after 100ms (2 attempts)');
+    expect(requestsReceived).toBe(2);
+    expect(elapsed).toBeGreaterThanOrEqual(190);
+    expect(elapsed).toBeLessThan(5000);
+    expect(client.getStats()).toMatchObject({ timeouts: 2, retries: 1, retriesExhausted: 1 });
+  });
+
+  it('does not resend a write that timed out', async () => {
+    const client = new TrelloClient({
+      apiKey: 'test-key',
+      token: 'test-token',
+      timeoutMs: 100,
+      maxRetries: 2,
+      baseDelayMs: 0,
+      maxDelayMs: 0,
+    });
+    (client as any).axiosInstance.defaults.baseURL = baseURL;
+    requestsReceived = 0;
+
+    const error = await client.addLabelToCard('c1', 'l1').catch(err => err);
+
+    expect(error).toBeInstanceOf(TrelloTimeoutError);
+    expect(error.message).toContain('(1 attempt)');
+    expect(requestsReceived).toBe(1);
+    expect(client.getStats()).toMatchObject({ timeouts: 1, retries: 0, retriesExhausted: 0 });
+  });
+
+  it('rejects a malformed board id before sending the request', async () => {
+    const client = new TrelloClient({ apiKey: 'test-key', token: 'test-token', timeoutMs: 100 });
+    (client as any).axiosInstance.defaults.baseURL = baseURL;
+    requestsReceived = 0;
+
+    const error = await client.getBoardById('b1').catch(err => err);
+
+    expect(error).toBeInstanceOf(McpError);
+    expect(error.message).toContain('Board id "b1"');
+    expect(requestsReceived).toBe(0);
+  });
+});
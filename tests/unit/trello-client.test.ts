@@ -1,5 +1,7 @@
 import { describe, it, expect, vi, beforeEach } from 'vitest';
 import axios from 'axios';
+import * as fsPromises from 'fs/promises';
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
 import { TrelloClient } from '../../src/trello-client.js';
 
 // Shared mock instance that axios.create will return
@@ -40,6 +42,7 @@ vi.mock('fs/promises', () => ({
   }),
   writeFile: vi.fn(async () => {}),
   access: vi.fn(async () => {}),
+  realpath: vi.fn(async (p: string) => p),
 }));
 
 function createClient(overrides?: {
@@ -78,6 +81,114 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('retries', () => {
+    it('should surface a rate limit error after maxRetries persistent 429s', async () => {
+      vi.mocked(axios.isAxiosError).mockReturnValue(true);
+      try {
+        mockAxiosInstance.get.mockRejectedValue({ response: { status: 429 }, message: 'Too Many' });
+
+        const client = new TrelloClient({
+          apiKey: 'test-key',
+          token: 'test-token',
+          maxRetries: 2,
+          baseDelayMs: 0,
+          maxDelayMs: 0,
+        });
+
+        await expect(client.getBoardById('b1')).rejects.toThrow(
+          'Trello API rate limit exceeded after 2 retries'
+        );
+        expect(mockAxiosInstance.get).toHaveBeenCalledTimes(3);
+        expect(client.getStats()).toMatchObject({
+          requests: 1,
+          retries: 2,
+          retriesExhausted: 1,
+          failures: 1,
+        });
+      } finally {
+        vi.mocked(axios.isAxiosError).mockReturnValue(false);
+        mockAxiosInstance.get.mockReset();
+      }
+    });
+  });
+
+  describe('request errors', () => {
+    it('should pass an McpError raised inside a request through unchanged', async () => {
+      mockAxiosInstance.get.mockRejectedValueOnce(
+        new McpError(ErrorCode.InvalidParams, 'Board id "x": not a valid Trello id')
+      );
+
+      const client = createClient();
+      await expect(client.getBoardById('x')).rejects.toThrow('not a valid Trello id');
+      expect(mockAxiosInstance.get).toHaveBeenCalledTimes(1);
+      expect(client.getStats()).toMatchObject({ requests: 1, retries: 0, failures: 1 });
+    });
+
+    it('should still wrap other non-HTTP errors', async () => {
+      mockAxiosInstance.get.mockRejectedValueOnce(new Error('socket hang up'));
+
+      await expect(createClient().getBoardById('b1')).rejects.toThrow(
+        'An unexpected error occurred'
+      );
+    });
+  });
+
+  describe('pingApi', () => {
+    it('should report latency and rate-limit headers on success', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({
+        status: 200,
+        data: { id: 'm1' },
+        headers: {
+          'x-rate-limit-api-token-remaining': '99',
+          'x-rate-limit-api-key-remaining': '299',
+          'content-type': 'application/json',
+        },
+      });
+
+      const result = await createClient().pingApi();
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/me', { params: { fields: 'id' } });
+      expect(result).toMatchObject({
+        reachable: true,
+        credentialsValid: true,
+        httpStatus: 200,
+        apiBaseUrl: 'https://api.trello.com/1',
+        rateLimit: {
+          'x-rate-limit-api-token-remaining': '99',
+          'x-rate-limit-api-key-remaining': '299',
+        },
+      });
+    });
+
+    it('should flag invalid credentials without throwing', async () => {
+      vi.mocked(axios.isAxiosError).mockReturnValue(true);
+      try {
+        mockAxiosInstance.get.mockRejectedValueOnce(
+          Object.assign(new Error('Unauthorized'), { response: { status: 401, headers: {} } })
+        );
+
+        const result = await createClient().pingApi();
+
+        expect(result).toMatchObject({ reachable: true, credentialsValid: false, httpStatus: 401 });
+      } finally {
+        vi.mocked(axios.isAxiosError).mockReturnValue(false);
+      }
+    });
+
+    it('should report an unreachable API', async () => {
+      mockAxiosInstance.get.mockRejectedValueOnce(new Error('getaddrinfo ENOTFOUND'));
+
+      const result = await createClient().pingApi();
+
+      expect(result).toMatchObject({
+        reachable: false,
+        credentialsValid: null,
+        httpStatus: null,
+        error: 'getaddrinfo ENOTFOUND',
+      });
+    });
+  });
+
   describe('workspace restriction', () => {
     it('should reject access to a non-allowed workspace before making a request', async () => {
       const client = createClient({ allowedWorkspaceIds: ['allowed-workspace'] });
@@ -89,6 +200,236 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('createBoard', () => {
+    it('should resolve workspaceName to an organization ID', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'org1', name: 'eng', displayName: 'Engineering' },
+          { id: 'org2', name: 'ops', displayName: 'Operations' },
+        ],
+      });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'b1' } });
+
+      const client = createClient();
+      await client.createBoard({ name: 'Roadmap', workspaceName: 'engineering' });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/me/organizations');
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/boards',
+        expect.objectContaining({ name: 'Roadmap', idOrganization: 'org1' })
+      );
+    });
+
+    it('should reject an unknown workspaceName without creating a board', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: [] });
+
+      const client = createClient();
+      await expect(
+        client.createBoard({ name: 'Roadmap', workspaceName: 'Nowhere' })
+      ).rejects.toThrow('Workspace "Nowhere" not found');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+
+    it('should seed labels after creating the board and report failures', async () => {
+      mockAxiosInstance.post.mockImplementation(async (url: string, body: { name: string }) => {
+        if (url === '/boards') return { data: { id: 'b1', name: body.name } };
+        if (body.name === 'broken') throw new Error('API Error');
+        return { data: { id: `label-${body.name}`, name: body.name } };
+      });
+
+      const board = await createClient().createBoard({
+        name: 'Roadmap',
+        defaultLabels: false,
+        labels: [
+          { name: 'bug', color: 'red' },
+          { name: 'broken', color: 'nope' },
+        ],
+      });
+
+      expect(board.labels).toEqual([{ id: 'label-bug', name: 'bug' }]);
+      expect(board.labelErrors).toEqual([{ name: 'broken', error: expect.any(String) }]);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/boards/b1/labels', {
+        name: 'bug',
+        color: 'red',
+      });
+      mockAxiosInstance.post.mockReset();
+    });
+  });
+
+  describe('sortList', () => {
+    it('should update only the cards whose position changes', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'c2', name: 'B', due: null, dateLastActivity: '', pos: 65536 },
+          { id: 'c1', name: 'A', due: null, dateLastActivity: '', pos: 131072 },
+        ],
+      });
+      mockAxiosInstance.put.mockResolvedValue({ data: {} });
+
+      const client = createClient();
+      const result = await client.sortList({ listId: 'l1', sortBy: 'name', order: 'asc' });
+
+      expect(result.order).toEqual([
+        { id: 'c1', name: 'A', pos: 65536 },
+        { id: 'c2', name: 'B', pos: 131072 },
+      ]);
+      expect(result.failures).toEqual([]);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/lists/l1/cards', {
+        params: { fields: 'name,due,dateLastActivity,pos' },
+      });
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(2);
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { pos: 65536 });
+    });
+
+    it('should reject a list on a different board', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'l1', idBoard: 'other' } });
+
+      const client = createClient();
+      await expect(
+        client.sortList({ listId: 'l1', sortBy: 'due', order: 'asc', boardId: 'b1' })
+      ).rejects.toThrow('belongs to board other');
+    });
+  });
+
+  describe('Power-Ups', () => {
+    const CUSTOM_FIELDS = '56d5e249a98895a9797bebb9';
+
+    it('enablePowerUp should post the resolved plugin ID when not yet enabled', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: [] })
+        .mockResolvedValueOnce({ data: [{ id: 'bp1', idBoard: 'b1', idPlugin: CUSTOM_FIELDS }] });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'bp1' } });
+
+      const client = createClient({ boardId: 'b1' });
+      const plugins = await client.enablePowerUp(undefined, 'custom-fields');
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/boards/b1/boardPlugins', {
+        idPlugin: CUSTOM_FIELDS,
+      });
+      expect(plugins).toHaveLength(1);
+    });
+
+    it('enablePowerUp should skip the post when already enabled', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({
+        data: [{ id: 'bp1', idBoard: 'b1', idPlugin: CUSTOM_FIELDS }],
+      });
+
+      const client = createClient({ boardId: 'b1' });
+      await client.enablePowerUp('b1', 'custom-fields');
+
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+
+    it('disablePowerUp should delete the board plugin', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: [{ id: 'bp1', idBoard: 'b1', idPlugin: CUSTOM_FIELDS }] })
+        .mockResolvedValueOnce({ data: [] });
+      mockAxiosInstance.delete.mockResolvedValue({});
+
+      const client = createClient();
+      const plugins = await client.disablePowerUp('b1', CUSTOM_FIELDS);
+
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith(`/boards/b1/boardPlugins/${CUSTOM_FIELDS}`);
+      expect(plugins).toEqual([]);
+    });
+  });
+
+  describe('id validation', () => {
+    it('should reject a malformed board id before the request is sent', () => {
+      createClient();
+      const interceptors = mockAxiosInstance.interceptors.request.use.mock.calls.map(
+        ([interceptor]) => interceptor
+      );
+      const validate = interceptors[interceptors.length - 1];
+
+      expect(() => validate({ method: 'get', url: '/boards/not-a-board' })).toThrow(
+        "that doesn't look like a valid Trello id"
+      );
+      const config = { method: 'get', url: '/boards/5f1b2c3d4e5f6a7b8c9d0e1f' };
+      expect(validate(config)).toBe(config);
+    });
+  });
+
+  describe('copyBoard', () => {
+    it('should post idBoardSource and keep cards by default', async () => {
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'b2', url: 'https://trello.com/b/x' } });
+
+      const client = createClient();
+      await client.copyBoard({ sourceBoardId: 'b1', name: 'Sprint 12', idOrganization: 'org1' });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/boards', {
+        name: 'Sprint 12',
+        idBoardSource: 'b1',
+        keepFromSource: 'cards',
+        idOrganization: 'org1',
+      });
+    });
+
+    it('should skip cards when keepCards is false', async () => {
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'b2' } });
+
+      const client = createClient();
+      await client.copyBoard({ sourceBoardId: 'b1', name: 'Empty', keepCards: false });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/boards',
+        expect.objectContaining({ keepFromSource: 'none' })
+      );
+    });
+
+    it('should enforce workspace restrictions', async () => {
+      const client = createClient({ allowedWorkspaceIds: ['org1'] });
+      await expect(
+        client.copyBoard({ sourceBoardId: 'b1', name: 'X', idOrganization: 'org2' })
+      ).rejects.toThrow("Access to workspace 'org2' is not allowed");
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('board templates', () => {
+    it('listBoardTemplates should return only open template boards', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'b1', name: 'Sprint', desc: '', closed: false, idOrganization: 'org1', url: 'u1', prefs: { isTemplate: true } },
+          { id: 'b2', name: 'Work', desc: '', closed: false, idOrganization: 'org1', url: 'u2', prefs: {} },
+          { id: 'b3', name: 'Old', desc: '', closed: true, idOrganization: 'org1', url: 'u3', prefs: { isTemplate: true } },
+        ],
+      });
+
+      const client = createClient();
+      const templates = await client.listBoardTemplates();
+
+      expect(templates).toEqual([
+        { id: 'b1', name: 'Sprint', desc: '', url: 'u1', idOrganization: 'org1' },
+      ]);
+    });
+
+    it('createBoardFromTemplate should copy a template board', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'b1', name: 'Sprint', prefs: { isTemplate: true } },
+      });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'b9', name: 'Sprint 13' } });
+
+      const client = createClient();
+      await client.createBoardFromTemplate({ templateBoardId: 'b1', name: 'Sprint 13' });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/boards',
+        expect.objectContaining({ name: 'Sprint 13', idBoardSource: 'b1', keepFromSource: 'cards' })
+      );
+    });
+
+    it('createBoardFromTemplate should refuse a board that is not a template', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'b2', name: 'Work', prefs: {} } });
+
+      const client = createClient();
+      await expect(
+        client.createBoardFromTemplate({ templateBoardId: 'b2', name: 'X' })
+      ).rejects.toThrow('is not a template');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+  });
+
   describe('listBoards', () => {
     it('should fetch user boards', async () => {
       const boards = [{ id: 'b1', name: 'Board 1' }];
@@ -115,6 +456,94 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('getBoardPreferences', () => {
+    it('should read prefs and normalize missing values to null', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: {
+          id: 'b1',
+          prefs: { permissionLevel: 'org', voting: 'members', cardCovers: true, background: 'blue' },
+        },
+      });
+
+      const prefs = await createClient({ boardId: 'b1' }).getBoardPreferences();
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1', {
+        params: { fields: 'prefs' },
+      });
+      expect(prefs).toEqual({
+        permissionLevel: 'org',
+        voting: 'members',
+        comments: null,
+        cardCovers: true,
+        background: 'blue',
+        backgroundColor: null,
+        backgroundImage: null,
+        isTemplate: false,
+      });
+    });
+  });
+
+  describe('getBoardEmailAddress', () => {
+    it('should return the address and the list emailed cards land in', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) =>
+        url === '/boards/b1/myPrefs'
+          ? {
+              data: {
+                fullEmail: 'jane+abc123@boards.trello.com',
+                emailKey: 'abc123',
+                idEmailList: 'l2',
+                emailPosition: 'bottom',
+              },
+            }
+          : {
+              data: [
+                { id: 'l1', name: 'To Do' },
+                { id: 'l2', name: 'Inbox' },
+              ],
+            }
+      );
+
+      const result = await createClient({ boardId: 'b1' }).getBoardEmailAddress();
+
+      expect(result).toEqual({
+        email: 'jane+abc123@boards.trello.com',
+        emailKey: 'abc123',
+        defaultList: { id: 'l2', name: 'Inbox' },
+        position: 'bottom',
+      });
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('updateBoardPreferences', () => {
+    it('should send only provided prefs using prefs/ field names', async () => {
+      mockAxiosInstance.put.mockResolvedValue({
+        data: { id: 'b1', prefs: { background: 'green', cardCovers: false } },
+      });
+
+      const client = createClient();
+      const prefs = await client.updateBoardPreferences('b1', {
+        background: 'green',
+        cardCovers: false,
+        voting: undefined,
+      });
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/boards/b1', {
+        'prefs/background': 'green',
+        'prefs/cardCovers': false,
+      });
+      expect(prefs).toEqual({ background: 'green', cardCovers: false });
+    });
+
+    it('should reject an empty update', async () => {
+      const client = createClient({ boardId: 'b1' });
+      await expect(client.updateBoardPreferences(undefined, {})).rejects.toThrow(
+        'At least one of background'
+      );
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+  });
+
   describe('getLists', () => {
     it('should use provided boardId', async () => {
       const lists = [{ id: 'l1', name: 'List 1' }];
@@ -188,6 +617,62 @@ describe('TrelloClient', () => {
         idLabels: undefined,
       });
     });
+
+    it('should reject a start date after the due date', async () => {
+      await expect(
+        createClient().addCard(undefined, {
+          listId: 'l1',
+          name: 'Card',
+          dueDate: '2024-12-01T00:00:00Z',
+          start: '2024-12-31',
+        })
+      ).rejects.toThrow('start (2024-12-31) must not be after the due date');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('addCard idempotency', () => {
+    it('should create only one card when a create is retried with the same key', async () => {
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'c1', name: 'Once' } });
+
+      const client = createClient();
+      const params = { listId: 'l1', name: 'Once', idempotencyKey: 'key-1' };
+      const first = await client.addCard(undefined, params);
+      const retried = await client.addCard(undefined, params);
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(1);
+      expect(retried).toEqual(first);
+    });
+
+    it('should post again after the key expires', async () => {
+      vi.useFakeTimers();
+      try {
+        mockAxiosInstance.post.mockResolvedValue({ data: { id: 'c1' } });
+
+        const client = createClient();
+        await client.addCard(undefined, { listId: 'l1', name: 'A', idempotencyKey: 'key-2' });
+        vi.advanceTimersByTime(TrelloClient.IDEMPOTENCY_TTL_MS + 1);
+        await client.addCard(undefined, { listId: 'l1', name: 'A', idempotencyKey: 'key-2' });
+
+        expect(mockAxiosInstance.post).toHaveBeenCalledTimes(2);
+      } finally {
+        vi.useRealTimers();
+      }
+    });
+
+    it('should allow retrying a key whose creation failed', async () => {
+      mockAxiosInstance.post
+        .mockRejectedValueOnce(new Error('network'))
+        .mockResolvedValueOnce({ data: { id: 'c2' } });
+
+      const client = createClient();
+      const params = { listId: 'l1', name: 'B', idempotencyKey: 'key-3' };
+      await expect(client.addCard(undefined, params)).rejects.toThrow();
+      const card = await client.addCard(undefined, params);
+
+      expect(card).toEqual({ id: 'c2' });
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(2);
+    });
   });
 
   describe('updateCard', () => {
@@ -245,7 +730,74 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('patchCard', () => {
+    it('should send only the provided fields', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', start: null, due: null } });
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1' } });
+
+      await createClient().patchCard('c1', {
+        name: 'Renamed',
+        idMembers: ['m1'],
+        due: '2024-06-01T00:00:00.000Z',
+        dueComplete: true,
+      });
+
+      // Fetched once to compare the new due date with the card's start
+      expect(mockAxiosInstance.get).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', {
+        name: 'Renamed',
+        idMembers: ['m1'],
+        due: '2024-06-01T00:00:00.000Z',
+        dueComplete: true,
+      });
+    });
+
+    it('should reject dueComplete on a card without a due date', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', due: null } });
+
+      await expect(createClient().patchCard('c1', { dueComplete: true })).rejects.toThrow(
+        'dueComplete requires a due date'
+      );
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+
+    it('should reject an empty update', async () => {
+      await expect(createClient().patchCard('c1', {})).rejects.toThrow(
+        'At least one card field must be provided'
+      );
+    });
+
+    it('should compare a lone start or due date with the date already on the card', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'c1', start: '2024-06-10T00:00:00.000Z', due: '2024-06-20T00:00:00.000Z' },
+      });
+
+      await expect(
+        createClient().patchCard('c1', { start: '2024-06-30T00:00:00.000Z' })
+      ).rejects.toThrow('must not be after the due date (2024-06-20T00:00:00.000Z)');
+      await expect(
+        createClient().patchCard('c1', { due: '2024-06-01T00:00:00.000Z' })
+      ).rejects.toThrow('start (2024-06-10T00:00:00.000Z) must not be after the due date');
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should reject a start date that cannot be parsed', async () => {
+      await expect(createClient().patchCard('c1', { start: 'next tuesday' })).rejects.toThrow(
+        'start "next tuesday" is not a valid date'
+      );
+      expect(mockAxiosInstance.get).not.toHaveBeenCalled();
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+  });
+
   describe('moveCard', () => {
+    beforeEach(() => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'c1', idBoard: 'b1', idList: 'l1', pos: 1024 },
+      });
+    });
+
     it('should update card list', async () => {
       mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1' } });
 
@@ -266,44 +818,205 @@ describe('TrelloClient', () => {
         idBoard: 'b2',
       });
     });
-  });
 
-  describe('addList', () => {
-    it('should create list on board', async () => {
-      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'l1', name: 'New List' } });
+    it('undoLastMove should restore the previous list and position', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1' } });
 
-      const client = createClient({ boardId: 'b1' });
-      await client.addList(undefined, 'New List');
+      const client = createClient();
+      await client.moveCard(undefined, 'c1', 'l2', 'top');
+      await client.undoLastMove();
 
-      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/lists', {
-        name: 'New List',
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'idBoard,idList,pos' },
+      });
+      expect(mockAxiosInstance.put).toHaveBeenLastCalledWith('/cards/c1', {
+        idList: 'l1',
         idBoard: 'b1',
+        pos: 1024,
       });
     });
 
-    it('should throw when no board ID available', async () => {
+    it('undoLastMove should forget the board cached for the card', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1' } });
       const client = createClient();
-      await expect(client.addList(undefined, 'List')).rejects.toThrow(
-        'boardId is required'
-      );
+      await client.moveCard('b2', 'c1', 'l9');
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: { id: 'c1', idBoard: 'b2' } });
+      expect(await client.resolveCardBoardId('c1')).toBe('b2');
+
+      await client.undoLastMove();
+
+      expect(await client.resolveCardBoardId('c1')).toBe('b1');
     });
-  });
 
-  describe('archiveList', () => {
-    it('should set list closed value to true', async () => {
-      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'l1' } });
+    it('undoLastMove should refuse when there is nothing to undo', async () => {
+      await expect(createClient().undoLastMove()).rejects.toThrow('Nothing to undo');
+    });
+
+    it('should forget the move after any other write', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1' } });
 
       const client = createClient();
-      await client.archiveList(undefined, 'l1');
+      await client.moveCard(undefined, 'c1', 'l2');
+      // axios is mocked, so run the registered request interceptors by hand
+      for (const [interceptor] of mockAxiosInstance.interceptors.request.use.mock.calls) {
+        await interceptor({ method: 'post' });
+      }
 
-      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/lists/l1/closed', { value: true });
+      await expect(client.undoLastMove()).rejects.toThrow('Nothing to undo');
     });
   });
 
-  describe('updateList', () => {
-    it('should update list metadata without position fields', async () => {
-      const list = { id: 'l1', name: 'Updated List' };
-      mockAxiosInstance.put.mockResolvedValue({ data: list });
+  describe('computeRelativeCardPosition', () => {
+    it('should place the card between the reference and its neighbor, ignoring the moving card', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: { id: 'ref', idList: 'l1', pos: 2000 } })
+        .mockResolvedValueOnce({
+          data: [
+            { id: 'c0', pos: 1000 },
+            { id: 'moving', pos: 1500 },
+            { id: 'ref', pos: 2000 },
+          ],
+        });
+
+      const client = createClient();
+      const pos = await client.computeRelativeCardPosition('moving', 'l1', 'ref', 'above');
+
+      expect(pos).toBe(1500);
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(2, '/lists/l1/cards', {
+        params: { fields: 'pos' },
+      });
+    });
+
+    it('should reject a reference card in a different list', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: { id: 'ref', idList: 'other', pos: 1 } });
+
+      const client = createClient();
+      await expect(
+        client.computeRelativeCardPosition('moving', 'l1', 'ref', 'below')
+      ).rejects.toThrow('Reference card ref is in list other');
+    });
+  });
+
+  describe('addList', () => {
+    it('should create list on board', async () => {
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'l1', name: 'New List' } });
+
+      const client = createClient({ boardId: 'b1' });
+      await client.addList(undefined, 'New List');
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/lists', {
+        name: 'New List',
+        idBoard: 'b1',
+      });
+    });
+
+    it('should throw when no board ID available', async () => {
+      const client = createClient();
+      await expect(client.addList(undefined, 'List')).rejects.toThrow(
+        'boardId is required'
+      );
+    });
+  });
+
+  describe('createList', () => {
+    it('should check the board and pass position and source list', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'b1', name: 'Board' } });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'l2', name: 'Doing' } });
+
+      await createClient().createList({
+        boardId: 'b1',
+        name: 'Doing',
+        pos: 'top',
+        idListSource: 'l1',
+      });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1');
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/lists', {
+        name: 'Doing',
+        idBoard: 'b1',
+        pos: 'top',
+        idListSource: 'l1',
+      });
+    });
+
+    it('should not create a list on a missing board', async () => {
+      vi.mocked(axios.isAxiosError).mockReturnValue(true);
+      try {
+        mockAxiosInstance.get.mockRejectedValueOnce({ response: { status: 404 }, message: 'Not found' });
+
+        await expect(createClient().createList({ boardId: 'nope', name: 'X' })).rejects.toThrow(
+          'Board nope not found or not accessible'
+        );
+        expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+      } finally {
+        vi.mocked(axios.isAxiosError).mockReturnValue(false);
+      }
+    });
+
+    it('should keep errors other than not-found from the board check', async () => {
+      vi.mocked(axios.isAxiosError).mockReturnValue(true);
+      try {
+        mockAxiosInstance.get.mockRejectedValueOnce({ response: { status: 401 }, message: 'Unauthorized' });
+
+        await expect(createClient().createList({ boardId: 'b1', name: 'X' })).rejects.toThrow(
+          'Trello API Error: 401 Unauthorized'
+        );
+        expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+      } finally {
+        vi.mocked(axios.isAxiosError).mockReturnValue(false);
+      }
+    });
+  });
+
+  describe('duplicateList', () => {
+    it('should copy the list onto the source board and count copied cards', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: { id: 'l1', idBoard: 'b1' } })
+        .mockResolvedValueOnce({ data: [{ id: 'c1', idLabels: [] }, { id: 'c2', idLabels: [] }] });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'l2', name: 'Copy', idBoard: 'b1' } });
+
+      const client = createClient();
+      const result = await client.duplicateList({ sourceListId: 'l1', name: 'Copy' });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/lists', {
+        name: 'Copy',
+        idBoard: 'b1',
+        idListSource: 'l1',
+      });
+      expect(result.cardCount).toBe(2);
+      expect(result.warnings).toEqual([]);
+    });
+
+    it('should warn when labels are lost copying to another board', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: { id: 'l1', idBoard: 'b1' } })
+        .mockResolvedValueOnce({ data: [{ id: 'c3', idLabels: [] }] })
+        .mockResolvedValueOnce({ data: [{ id: 'c1', idLabels: ['lab1'] }] });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'l2', idBoard: 'b2' } });
+
+      const client = createClient();
+      const result = await client.duplicateList({ sourceListId: 'l1', name: 'Copy', boardId: 'b2' });
+
+      expect(result.warnings).toHaveLength(1);
+      expect(result.warnings[0]).toContain('1 of 1 labelled cards lost their labels');
+    });
+  });
+
+  describe('archiveList', () => {
+    it('should set list closed value to true', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'l1' } });
+
+      const client = createClient();
+      await client.archiveList(undefined, 'l1');
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/lists/l1/closed', { value: true });
+    });
+  });
+
+  describe('updateList', () => {
+    it('should update list metadata without position fields', async () => {
+      const list = { id: 'l1', name: 'Updated List' };
+      mockAxiosInstance.put.mockResolvedValue({ data: list });
 
       const client = createClient();
       const result = await client.updateList('l1', {
@@ -356,6 +1069,46 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('getDueSoon', () => {
+    const hoursFromNow = (hours: number) => new Date(Date.now() + hours * 3600000).toISOString();
+
+    it('should return incomplete cards due within the window, soonest first', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'later', due: hoursFromNow(30), dueComplete: false },
+          { id: 'soon', due: hoursFromNow(2), dueComplete: false },
+          { id: 'overdue', due: hoursFromNow(-5), dueComplete: false },
+          { id: 'done', due: hoursFromNow(1), dueComplete: true },
+          { id: 'far', due: hoursFromNow(100), dueComplete: false },
+          { id: 'none', due: null, dueComplete: false },
+        ],
+      });
+
+      const client = createClient();
+      const cards = await client.getDueSoon({ boardId: 'b1', withinHours: 48, includeOverdue: true });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/cards', {
+        params: { fields: 'name,due,dueComplete,idList,idBoard,url' },
+      });
+      expect(cards.map(card => card.id)).toEqual(['overdue', 'soon', 'later']);
+    });
+
+    it('should scan my cards and drop overdue ones when asked', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'overdue', due: hoursFromNow(-1), dueComplete: false },
+          { id: 'soon', due: hoursFromNow(1), dueComplete: false },
+        ],
+      });
+
+      const client = createClient();
+      const cards = await client.getDueSoon({ withinHours: 24, includeOverdue: false });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/me/cards', expect.anything());
+      expect(cards.map(card => card.id)).toEqual(['soon']);
+    });
+  });
+
   describe('Comments', () => {
     it('addCommentToCard should post comment', async () => {
       mockAxiosInstance.post.mockResolvedValue({ data: { id: 'comment1' } });
@@ -379,6 +1132,72 @@ describe('TrelloClient', () => {
       );
     });
 
+    it('addCommentWithMentions should resolve members on the card board', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: { id: 'c1', idBoard: 'b9' } })
+        .mockResolvedValueOnce({ data: [{ id: 'm1', username: 'alice' }] });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'a1' } });
+
+      const client = createClient();
+      const result = await client.addCommentWithMentions('c1', 'ping', ['m1', 'm2']);
+
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(2, '/boards/b9/members');
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        `cards/c1/actions/comments?text=${encodeURIComponent('@alice ping')}`
+      );
+      expect(result.skippedMentions).toEqual([
+        { memberId: 'm2', reason: 'not a member of this board' },
+      ]);
+    });
+
+    it('addCommentToCard should reject a comment over the length limit without posting', async () => {
+      const client = createClient();
+
+      await expect(client.addCommentToCard('c1', 'x'.repeat(16385))).rejects.toThrow(
+        'Comment is 16385 characters; Trello allows at most 16384'
+      );
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+
+    it('addCommentInParts should post a long comment as numbered parts in order', async () => {
+      let next = 0;
+      mockAxiosInstance.post.mockImplementation(async () => ({ data: { id: `a${++next}` } }));
+
+      const client = createClient();
+      const paragraph = `${'word '.repeat(2000).trim()}\n\n`;
+      const result = await client.addCommentInParts('c1', paragraph.repeat(4));
+
+      const posted = mockAxiosInstance.post.mock.calls.map(([url]) =>
+        decodeURIComponent(url.split('?text=')[1])
+      );
+      expect(posted.length).toBeGreaterThan(1);
+      expect(posted.every(text => text.length <= 16384)).toBe(true);
+      expect(posted[0].startsWith(`(1/${posted.length}) `)).toBe(true);
+      expect(result).toEqual({
+        commentIds: posted.map((_, i) => `a${i + 1}`),
+        skippedMentions: [],
+      });
+      mockAxiosInstance.post.mockReset();
+    });
+
+    it('addCommentInParts should report the posted parts when a later part fails', async () => {
+      mockAxiosInstance.post
+        .mockResolvedValueOnce({ data: { id: 'a1' } })
+        .mockRejectedValueOnce(new Error('network'));
+
+      const client = createClient();
+      const paragraph = `${'word '.repeat(2000).trim()}\n\n`;
+      const result = await client.addCommentInParts('c1', paragraph.repeat(4));
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(2);
+      expect(result).toEqual({
+        commentIds: ['a1'],
+        skippedMentions: [],
+        failure: { part: 2, totalParts: expect.any(Number), error: expect.any(String) },
+      });
+      expect(result.failure!.totalParts).toBeGreaterThan(2);
+    });
+
     it('updateCommentOnCard should return true on success', async () => {
       mockAxiosInstance.put.mockResolvedValue({ status: 200, data: {} });
 
@@ -419,6 +1238,46 @@ describe('TrelloClient', () => {
   });
 
   describe('Checklists', () => {
+    it('findChecklistsByName should return every checklist containing the text', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'cl1', name: 'Acceptance Criteria', checkItems: [] },
+          { id: 'cl2', name: 'Acceptance tests', checkItems: [] },
+          { id: 'cl3', name: 'Tasks', checkItems: [] },
+        ],
+      });
+
+      const client = createClient({ boardId: 'b1' });
+      const matches = await client.findChecklistsByName('acceptance');
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/checklists');
+      expect(matches.map(checklist => checklist.id)).toEqual(['cl1', 'cl2']);
+      await expect(client.getChecklistByName('acceptance')).resolves.toBeNull();
+    });
+
+    it('addChecklistItem should refuse an ambiguous fuzzy checklist name', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: {
+          checklists: [
+            { id: 'cl1', name: 'Release tasks', checkItems: [] },
+            { id: 'cl2', name: 'Cleanup tasks', checkItems: [] },
+          ],
+        },
+      });
+
+      const client = new TrelloClient({
+        apiKey: 'test-key',
+        token: 'test-token',
+        nameMatching: 'fuzzy',
+      });
+
+      await expect(client.addChecklistItem('Ship it', 'tasks', 'c1')).rejects.toThrow(
+        'Checklist name "tasks" is ambiguous'
+      );
+      await expect(client.getChecklistByName('tasks', 'c1')).rejects.toThrow('is ambiguous');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+
     it('createChecklist should post to card', async () => {
       mockAxiosInstance.post.mockResolvedValue({ data: { id: 'cl1', name: 'Checklist' } });
 
@@ -445,6 +1304,99 @@ describe('TrelloClient', () => {
         state: 'complete',
       });
     });
+
+    it('updateChecklistItemPosition should resolve the item by text before moving it', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          {
+            id: 'cl1',
+            name: 'Tasks',
+            checkItems: [{ id: 'ci2', name: 'Ship it', state: 'incomplete', pos: 2 }],
+          },
+        ],
+      });
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'ci2', pos: 1 } });
+
+      const client = createClient();
+      await client.updateChecklistItemPosition({
+        cardId: 'c1',
+        itemText: 'ship it',
+        checklistName: 'Tasks',
+        position: 'top',
+      });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1/checklists');
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1/checkItem/ci2', { pos: 'top' });
+    });
+
+    it('updateChecklistItemPosition should require an item reference', async () => {
+      const client = createClient();
+      await expect(
+        client.updateChecklistItemPosition({ cardId: 'c1', position: 'bottom' })
+      ).rejects.toThrow('Either checkItemId or itemText must be provided');
+    });
+
+    it('setChecklistItemDue should send only the provided due and member fields', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'ci1', due: '2024-06-01T00:00:00.000Z' } });
+
+      const client = createClient();
+      await client.setChecklistItemDue({
+        cardId: 'c1',
+        checkItemId: 'ci1',
+        due: '2024-06-01T00:00:00.000Z',
+      });
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1/checkItem/ci1', {
+        due: '2024-06-01T00:00:00.000Z',
+      });
+    });
+
+    it('setChecklistItemDue should explain the plan requirement on a permission error', async () => {
+      vi.mocked(axios.isAxiosError).mockReturnValue(true);
+      try {
+        mockAxiosInstance.put.mockRejectedValueOnce({ response: { status: 403 }, message: 'Forbidden' });
+
+        const client = createClient();
+        await expect(
+          client.setChecklistItemDue({ cardId: 'c1', checkItemId: 'ci1', memberId: 'm1' })
+        ).rejects.toThrow('require a Trello Premium');
+      } finally {
+        vi.mocked(axios.isAxiosError).mockReturnValue(false);
+      }
+    });
+
+    it('convertChecklistItemToCard should post directly when both IDs are known', async () => {
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'c2', idList: 'l1' } });
+
+      const client = createClient();
+      const card = await client.convertChecklistItemToCard({
+        cardId: 'c1',
+        checklistId: 'cl1',
+        checkItemId: 'ci1',
+      });
+
+      expect(mockAxiosInstance.get).not.toHaveBeenCalled();
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards/c1/checklist/cl1/checkItem/ci1/convertToCard'
+      );
+      expect(card).toEqual({ id: 'c2', idList: 'l1' });
+    });
+
+    it('convertChecklistItemToCard should resolve the checklist from item text', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'cl9', name: 'Subtasks', checkItems: [{ id: 'ci9', name: 'Grow me', state: 'incomplete', pos: 1 }] },
+        ],
+      });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'c2' } });
+
+      const client = createClient();
+      await client.convertChecklistItemToCard({ cardId: 'c1', itemText: 'Grow me' });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards/c1/checklist/cl9/checkItem/ci9/convertToCard'
+      );
+    });
   });
 
   describe('Members', () => {
@@ -466,6 +1418,52 @@ describe('TrelloClient', () => {
       expect(mockAxiosInstance.post).toHaveBeenCalledWith('/cards/c1/idMembers', { value: 'm1' });
     });
 
+    it('assignMembersToCard should skip members already assigned', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', idMembers: ['m1'] } });
+      mockAxiosInstance.post.mockResolvedValue({ data: [] });
+
+      const client = createClient();
+      const result = await client.assignMembersToCard('c1', ['m1', 'm2', 'm2']);
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'idMembers' },
+      });
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/cards/c1/idMembers', { value: 'm2' });
+      expect(result.results).toEqual([
+        { memberId: 'm1', success: true, status: 'skipped', reason: 'already present' },
+        { memberId: 'm2', success: true, status: 'added' },
+      ]);
+    });
+
+    it('assignMembersToCard should not repeat assignments when re-run', async () => {
+      const idMembers: string[] = [];
+      mockAxiosInstance.get.mockImplementation(() =>
+        Promise.resolve({ data: { id: 'c1', idMembers: [...idMembers] } })
+      );
+      mockAxiosInstance.post
+        .mockImplementationOnce((_url: string, body: { value: string }) => {
+          idMembers.push(body.value);
+          return Promise.resolve({ data: idMembers });
+        })
+        .mockRejectedValueOnce(new Error('timeout'))
+        .mockImplementation((_url: string, body: { value: string }) => {
+          idMembers.push(body.value);
+          return Promise.resolve({ data: idMembers });
+        });
+
+      const client = createClient();
+      const first = await client.assignMembersToCard('c1', ['m1', 'm2']);
+      const second = await client.assignMembersToCard('c1', ['m1', 'm2']);
+
+      expect(first.results[1]).toMatchObject({ memberId: 'm2', success: false });
+      expect(second.results.map(r => r.status)).toEqual(['skipped', 'added']);
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(3);
+      expect(idMembers).toEqual(['m1', 'm2']);
+      mockAxiosInstance.get.mockReset();
+      mockAxiosInstance.post.mockReset();
+    });
+
     it('removeMemberFromCard should delete member', async () => {
       mockAxiosInstance.delete.mockResolvedValue({ data: [] });
 
@@ -476,7 +1474,74 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('setCardMembers', () => {
+    it('should only add missing members and remove extra ones', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', idMembers: ['m1', 'm2'] } });
+      mockAxiosInstance.post.mockResolvedValue({ data: [] });
+      mockAxiosInstance.delete.mockResolvedValue({ data: [] });
+
+      const client = createClient();
+      const changes = await client.setCardMembers('c1', ['m2', 'm3']);
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'idMembers' },
+      });
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/cards/c1/idMembers', { value: 'm3' });
+      expect(mockAxiosInstance.delete).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith('/cards/c1/idMembers/m1');
+      expect(changes).toEqual({ added: ['m3'], removed: ['m1'], unchanged: ['m2'] });
+    });
+
+    it('removeAllMembersFromCard should delete each member and count them', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', idMembers: ['m1', 'm2'] } });
+      mockAxiosInstance.delete.mockResolvedValue({ data: [] });
+
+      const result = await createClient().removeAllMembersFromCard(undefined, 'c1');
+
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith('/cards/c1/idMembers/m1');
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith('/cards/c1/idMembers/m2');
+      expect(result).toEqual({ removedCount: 2, removed: ['m1', 'm2'] });
+    });
+
+    it('removeAllMembersFromCard should be a no-op for a card without members', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', idMembers: [] } });
+
+      const result = await createClient().removeAllMembersFromCard(undefined, 'c1');
+
+      expect(mockAxiosInstance.delete).not.toHaveBeenCalled();
+      expect(result).toEqual({ removedCount: 0, removed: [] });
+    });
+  });
+
   describe('Labels', () => {
+    it('getBoardLabelsUsage should count open cards per label, most used first', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => {
+        if (url === '/boards/b1/labels') {
+          return {
+            data: [
+              { id: 'lbl1', name: 'Bug', color: 'red' },
+              { id: 'lbl2', name: 'Feature', color: 'green' },
+              { id: 'lbl3', name: 'Stale', color: 'sky' },
+            ],
+          };
+        }
+        return { data: [{ idLabels: ['lbl2'] }, { idLabels: ['lbl1', 'lbl2'] }, { idLabels: [] }] };
+      });
+
+      const usage = await createClient({ boardId: 'b1' }).getBoardLabelsUsage();
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/cards', {
+        params: { fields: 'idLabels', filter: 'open' },
+      });
+      expect(usage).toEqual([
+        { labelId: 'lbl2', name: 'Feature', color: 'green', cardCount: 2, unused: false },
+        { labelId: 'lbl1', name: 'Bug', color: 'red', cardCount: 1, unused: false },
+        { labelId: 'lbl3', name: 'Stale', color: 'sky', cardCount: 0, unused: true },
+      ]);
+      mockAxiosInstance.get.mockReset();
+    });
+
     it('createLabel should post to board', async () => {
       mockAxiosInstance.post.mockResolvedValue({ data: { id: 'lbl1' } });
 
@@ -551,6 +1616,76 @@ describe('TrelloClient', () => {
       expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/labels');
     });
 
+    it('addLabelToCards should resolve a color once and report per-card results', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'lab-red', name: 'Urgent', color: 'red' },
+          { id: 'lab-blue', name: 'Review', color: 'blue' },
+        ],
+      });
+      mockAxiosInstance.post
+        .mockResolvedValueOnce({ data: ['lab-blue'] })
+        .mockRejectedValueOnce(new Error('not found'));
+
+      const client = createClient({ boardId: 'b1' });
+      const result = await client.addLabelToCards({ cardIds: ['c1', 'c2'], color: 'Blue' });
+
+      const labelFetches = mockAxiosInstance.get.mock.calls.filter(
+        ([url]) => url === '/boards/b1/labels'
+      );
+      expect(labelFetches).toHaveLength(1);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/cards/c1/idLabels', { value: 'lab-blue' });
+      expect(result.labelId).toBe('lab-blue');
+      expect(result.results[0]).toEqual({ cardId: 'c1', success: true, status: 'added' });
+      expect(result.results[1]).toMatchObject({ cardId: 'c2', success: false });
+    });
+
+    it('addLabelToCards should skip cards that already have the label on a re-run', async () => {
+      const cardLabels: Record<string, string[]> = { c1: [], c2: [] };
+      mockAxiosInstance.get.mockImplementation((url: string) => {
+        const cardId = url.replace('/cards/', '');
+        return Promise.resolve({ data: { id: cardId, idLabels: cardLabels[cardId] } });
+      });
+      const applyLabel = (url: string, body: { value: string }) => {
+        const cardId = url.split('/')[2];
+        cardLabels[cardId].push(body.value);
+        return Promise.resolve({ data: cardLabels[cardId] });
+      };
+      mockAxiosInstance.post
+        .mockImplementationOnce(applyLabel)
+        .mockRejectedValueOnce(new Error('timeout'))
+        .mockImplementation(applyLabel);
+      const client = createClient();
+
+      const first = await client.addLabelToCards({
+        cardIds: ['c1', 'c2'],
+        labelId: 'lab-1',
+        concurrency: 1,
+      });
+      const second = await client.addLabelToCards({
+        cardIds: ['c1', 'c2'],
+        labelId: 'lab-1',
+        concurrency: 1,
+      });
+
+      expect(first.results.map(r => r.status)).toEqual(['added', undefined]);
+      expect(second.results).toEqual([
+        { cardId: 'c1', success: true, status: 'skipped', reason: 'already present' },
+        { cardId: 'c2', success: true, status: 'added' },
+      ]);
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(3);
+      expect(cardLabels).toEqual({ c1: ['lab-1'], c2: ['lab-1'] });
+      mockAxiosInstance.get.mockReset();
+      mockAxiosInstance.post.mockReset();
+    });
+
+    it('addLabelToCards should require a label reference', async () => {
+      const client = createClient();
+      await expect(client.addLabelToCards({ cardIds: ['c1'] })).rejects.toThrow(
+        'Either labelId or color must be provided'
+      );
+    });
+
     it('removeLabelFromCard should delete the card-label association', async () => {
       mockAxiosInstance.delete.mockResolvedValue({});
 
@@ -592,12 +1727,44 @@ describe('TrelloClient', () => {
       );
       expect(result).toEqual(item);
     });
-  });
 
-  describe('copyCard', () => {
-    it('should post with idCardSource and keepFromSource=all by default', async () => {
-      const copiedCard = { id: 'c2', name: 'Copied Card' };
-      mockAxiosInstance.post.mockResolvedValue({ data: copiedCard });
+    it('getCardCustomFieldValues should decode values and cache board definitions', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => {
+        if (url === '/boards/b1/customFields') {
+          return {
+            data: [
+              {
+                id: 'f1',
+                name: 'Priority',
+                type: 'list',
+                options: [{ id: 'o1', idCustomField: 'f1', value: { text: 'High' } }],
+              },
+            ],
+          };
+        }
+        return { data: { idBoard: 'b1', customFieldItems: [{ idCustomField: 'f1', idValue: 'o1' }] } };
+      });
+
+      const client = createClient();
+      const first = await client.getCardCustomFieldValues('c1');
+      await client.getCardCustomFieldValues('c2');
+
+      expect(first).toEqual([{ id: 'f1', name: 'Priority', type: 'list', value: 'High' }]);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'idBoard', customFieldItems: true },
+      });
+      const definitionFetches = mockAxiosInstance.get.mock.calls.filter(
+        ([url]) => url === '/boards/b1/customFields'
+      );
+      expect(definitionFetches).toHaveLength(1);
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('copyCard', () => {
+    it('should post with idCardSource and keepFromSource=all by default', async () => {
+      const copiedCard = { id: 'c2', name: 'Copied Card' };
+      mockAxiosInstance.post.mockResolvedValue({ data: copiedCard });
 
       const client = createClient();
       const result = await client.copyCard({
@@ -649,37 +1816,1329 @@ describe('TrelloClient', () => {
         cardId: 'c2',
       });
 
-      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/checklists', {
-        idCard: 'c2',
-        idChecklistSource: 'cl1',
-        name: undefined,
-        pos: undefined,
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/checklists', {
+        idCard: 'c2',
+        idChecklistSource: 'cl1',
+        name: undefined,
+        pos: undefined,
+      });
+      expect(result).toEqual(checklist);
+    });
+
+    it('should allow overriding name', async () => {
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'cl2' } });
+
+      const client = createClient();
+      await client.copyChecklist({
+        sourceChecklistId: 'cl1',
+        cardId: 'c2',
+        name: 'Renamed Checklist',
+        pos: 'top',
+      });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/checklists', {
+        idCard: 'c2',
+        idChecklistSource: 'cl1',
+        name: 'Renamed Checklist',
+        pos: 'top',
+      });
+    });
+  });
+
+  describe('findCardsByMember', () => {
+    const members = [
+      { id: 'm1', username: 'jane', fullName: 'Jane Doe', avatarUrl: null },
+      { id: 'm2', username: 'jd2', fullName: 'John Doe', avatarUrl: null },
+      { id: 'm3', username: 'jd3', fullName: 'John Doe', avatarUrl: null },
+    ];
+
+    beforeEach(() => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) =>
+        url.endsWith('/members')
+          ? { data: members }
+          : {
+              data: [
+                { id: 'c1', name: 'Mine', idMembers: ['m1'] },
+                { id: 'c2', name: 'Shared', idMembers: ['m2', 'm1'] },
+                { id: 'c3', name: 'Other', idMembers: ['m2'] },
+              ],
+            }
+      );
+    });
+
+    it('should resolve a member by username or full name and filter cards', async () => {
+      const client = createClient({ boardId: 'b1' });
+
+      const byUsername = await client.findCardsByMember(undefined, '@Jane');
+      expect(byUsername.member.id).toBe('m1');
+      expect(byUsername.cards.map(card => card.id)).toEqual(['c1', 'c2']);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/cards', {
+        params: { fields: 'name,idList,idMembers,url' },
+      });
+
+      const byName = await client.findCardsByMember(undefined, 'jane doe');
+      expect(byName.member.id).toBe('m1');
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should reject unknown and ambiguous members', async () => {
+      const client = createClient({ boardId: 'b1' });
+      await expect(client.findCardsByMember(undefined, 'nobody')).rejects.toThrow('not found');
+      await expect(client.findCardsByMember(undefined, 'John Doe')).rejects.toThrow('ambiguous');
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('setCardSubscription', () => {
+    it('should make the same write as watchCard and return the new state', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1', subscribed: false } });
+
+      const state = await createClient().setCardSubscription('c1', false);
+
+      expect(state).toEqual({ cardId: 'c1', subscribed: false });
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { subscribed: false });
+    });
+  });
+
+  describe('findInactiveCards and archiveCards', () => {
+    it('should select cards idle past the cutoff and archive them', async () => {
+      const now = Date.parse('2024-06-30T00:00:00.000Z');
+      const dateNow = vi.spyOn(Date, 'now').mockReturnValue(now);
+      try {
+        mockAxiosInstance.get.mockResolvedValue({
+          data: [
+            { id: 'c1', name: 'Stale', dateLastActivity: '2024-05-01T00:00:00.000Z' },
+            { id: 'c2', name: 'Fresh', dateLastActivity: '2024-06-25T00:00:00.000Z' },
+          ],
+        });
+        mockAxiosInstance.put.mockImplementation(async (url: string) => {
+          if (url === '/cards/c3') throw new Error('API Error');
+          return { data: {} };
+        });
+        const client = createClient();
+
+        const { cutoff, cards } = await client.findInactiveCards({
+          listId: 'l1',
+          olderThanDays: 30,
+        });
+        expect(cutoff).toBe('2024-05-31T00:00:00.000Z');
+        expect(cards.map(card => card.id)).toEqual(['c1']);
+        expect(mockAxiosInstance.get).toHaveBeenCalledWith('/lists/l1/cards', {
+          params: { fields: 'name,dateLastActivity' },
+        });
+
+        const result = await client.archiveCards(['c1', 'c3']);
+        expect(result).toEqual({
+          archived: ['c1'],
+          failures: [{ cardId: 'c3', error: expect.any(String) }],
+        });
+        expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { closed: true });
+      } finally {
+        dateNow.mockRestore();
+        mockAxiosInstance.put.mockReset();
+      }
+    });
+  });
+
+  describe('comment reactions', () => {
+    const summary = [{ count: 2, emoji: { shortName: 'thumbsup', native: '👍' } }];
+
+    it('should validate the emoji once and add the reaction', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) =>
+        url === '/emoji'
+          ? { data: { trello: [{ shortName: 'thumbsup', shortNames: ['+1'] }] } }
+          : { data: summary }
+      );
+      mockAxiosInstance.post.mockResolvedValue({ data: {} });
+      const client = createClient();
+
+      await expect(client.addCommentReaction('a1', ':thumbsup:')).resolves.toEqual([
+        { emoji: 'thumbsup', native: '👍', count: 2 },
+      ]);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/actions/a1/reactions', {
+        shortName: 'thumbsup',
+      });
+      await expect(client.addCommentReaction('a1', 'nope')).rejects.toThrow('Unknown emoji "nope"');
+      expect(
+        mockAxiosInstance.get.mock.calls.filter(([url]) => url === '/emoji')
+      ).toHaveLength(1);
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it("should delete only the current member's reaction", async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => {
+        if (url === '/emoji') return { data: { trello: [{ shortName: 'tada' }] } };
+        if (url === '/members/me') return { data: { id: 'me', username: 'me', fullName: 'Me' } };
+        if (url === '/actions/a1/reactions') {
+          return {
+            data: [
+              { id: 'r1', idMember: 'other', emoji: { shortName: 'tada' } },
+              { id: 'r2', idMember: 'me', emoji: { shortName: 'tada' } },
+            ],
+          };
+        }
+        return { data: [] };
+      });
+      mockAxiosInstance.delete.mockResolvedValue({ data: {} });
+      const client = createClient();
+
+      await expect(client.removeCommentReaction('a1', 'tada')).resolves.toEqual([]);
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith('/actions/a1/reactions/r2');
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('moveCardToListEdge', () => {
+    it('should change only pos and allow undo', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'c1', idBoard: 'b1', idList: 'l1', pos: 500 },
+      });
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1', idList: 'l1', pos: 8 } });
+      const client = createClient();
+
+      await expect(client.moveCardToListEdge('c1', 'top')).resolves.toEqual({
+        cardId: 'c1',
+        idList: 'l1',
+        pos: 8,
+      });
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { pos: 'top' });
+
+      await client.undoLastMove();
+      expect(mockAxiosInstance.put).toHaveBeenLastCalledWith('/cards/c1', {
+        idList: 'l1',
+        idBoard: 'b1',
+        pos: 500,
+      });
+    });
+  });
+
+  describe('ETag caching', () => {
+    it('should revalidate with If-None-Match and serve the cached body on 304', async () => {
+      const lists = [{ id: 'l1', name: 'Todo' }];
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ status: 200, data: lists, headers: { etag: 'W/"abc"' } })
+        .mockResolvedValueOnce({ status: 304, data: '', headers: { etag: 'W/"abc"' } });
+      const client = createClient();
+
+      await expect(client.getLists('b1')).resolves.toEqual(lists);
+      await expect(client.getLists('b1')).resolves.toEqual(lists);
+
+      expect(mockAxiosInstance.get).toHaveBeenLastCalledWith(
+        '/boards/b1/lists',
+        expect.objectContaining({ headers: { 'If-None-Match': 'W/"abc"' } })
+      );
+      expect(client.getStats().cacheHits).toBe(1);
+    });
+
+    it('should bypass the cache when noCache is set', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        status: 200,
+        data: [{ id: 'lb1' }],
+        headers: { etag: '"v1"' },
+      });
+      const client = createClient();
+
+      await client.getBoardLabels('b1');
+      await client.getBoardLabels('b1', { noCache: true });
+
+      expect(mockAxiosInstance.get).toHaveBeenLastCalledWith('/boards/b1/labels');
+      expect(client.getStats().cacheHits).toBe(0);
+    });
+  });
+
+  describe('commentOnCards', () => {
+    it('should resolve mentions once and report each card', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [{ id: 'm1', username: 'jane', fullName: 'Jane', avatarUrl: null }],
+      });
+      mockAxiosInstance.post.mockImplementation(async (url: string) => {
+        if (url.startsWith('cards/c2/')) throw new Error('API Error');
+        return { data: { id: `comment-${url.split('/')[1]}` } };
+      });
+
+      const result = await createClient().commentOnCards({
+        cardIds: ['c1', 'c2'],
+        text: 'Moved to sprint 12',
+        boardId: 'b1',
+        mentionMemberIds: ['m1', 'ghost'],
+      });
+
+      expect(result).toEqual({
+        results: [
+          { cardId: 'c1', success: true, commentId: 'comment-c1' },
+          { cardId: 'c2', success: false, error: expect.any(String) },
+        ],
+        skippedMentions: [{ memberId: 'ghost', reason: 'not a member of this board' }],
+      });
+      expect(mockAxiosInstance.get).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        `cards/c1/actions/comments?text=${encodeURIComponent('@jane Moved to sprint 12')}`
+      );
+      mockAxiosInstance.post.mockReset();
+    });
+  });
+
+  describe('resolveCardBoardId', () => {
+    it('should let a board-scoped operation run with only a card ID', async () => {
+      mockAxiosInstance.get.mockImplementation((url: string) =>
+        Promise.resolve(
+          url === '/cards/c1'
+            ? { data: { id: 'c1', idBoard: 'b9' } }
+            : { data: [{ id: 'lab-red', name: 'Urgent', color: 'red' }] }
+        )
+      );
+      mockAxiosInstance.post.mockResolvedValue({ data: ['lab-red'] });
+      const client = createClient();
+
+      const result = await client.addLabelToCards({ cardIds: ['c1'], color: 'red' });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'idBoard' },
+      });
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b9/labels');
+      expect(result.labelId).toBe('lab-red');
+    });
+
+    it('should cache the board and prefer an explicit boardId', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', idBoard: 'b9' } });
+      const client = createClient();
+
+      expect(await client.resolveCardBoardId('c1')).toBe('b9');
+      expect(await client.resolveCardBoardId('c1')).toBe('b9');
+      expect(await client.resolveCardBoardId('c1', 'b2')).toBe('b2');
+      expect(mockAxiosInstance.get).toHaveBeenCalledTimes(1);
+    });
+  });
+
+  describe('copyChecklistToCard', () => {
+    beforeEach(() => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: {
+          id: 'c1',
+          checklists: [
+            { id: 'cl1', name: 'Definition of Done', checkItems: [] },
+            { id: 'cl2', name: 'Launch', checkItems: [] },
+          ],
+        },
+      });
+    });
+
+    it('should copy a checklist picked by name and reset completed items', async () => {
+      mockAxiosInstance.post.mockResolvedValue({
+        data: {
+          id: 'new',
+          name: 'DoD',
+          checkItems: [
+            { id: 'i1', state: 'complete' },
+            { id: 'i2', state: 'incomplete' },
+          ],
+        },
+      });
+      mockAxiosInstance.put.mockResolvedValue({ data: {} });
+
+      const result = await createClient().copyChecklistToCard({
+        sourceCardId: 'c1',
+        sourceChecklist: 'definition of done',
+        targetCardId: 'c2',
+        name: 'DoD',
+        resetStates: true,
+      });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/cards/c2/checklists', {
+        idChecklistSource: 'cl1',
+        name: 'DoD',
+      });
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c2/checkItem/i1', {
+        state: 'incomplete',
+      });
+      expect(result).toEqual({
+        id: 'new',
+        name: 'DoD',
+        itemCount: 2,
+        resetItems: 1,
+        resetFailures: [],
+      });
+    });
+
+    it('should report items that could not be reset', async () => {
+      mockAxiosInstance.post.mockResolvedValue({
+        data: {
+          id: 'new',
+          name: 'Definition of Done',
+          checkItems: [
+            { id: 'i1', state: 'complete' },
+            { id: 'i2', state: 'complete' },
+            { id: 'i3', state: 'complete' },
+          ],
+        },
+      });
+      mockAxiosInstance.put.mockImplementation(async (url: string) => {
+        if (url.endsWith('/i2')) throw new Error('API Error');
+        return { data: {} };
+      });
+
+      const result = await createClient().copyChecklistToCard({
+        sourceCardId: 'c1',
+        sourceChecklist: 'cl1',
+        targetCardId: 'c2',
+        resetStates: true,
+      });
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(3);
+      expect(result.resetItems).toBe(2);
+      expect(result.resetFailures).toEqual([{ itemId: 'i2', error: expect.any(String) }]);
+      mockAxiosInstance.put.mockReset();
+    });
+
+    it('should list the available checklists when the name is unknown', async () => {
+      await expect(
+        createClient().copyChecklistToCard({
+          sourceCardId: 'c1',
+          sourceChecklist: 'QA',
+          targetCardId: 'c2',
+        })
+      ).rejects.toThrow('Card c1 has: Definition of Done, Launch.');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('getCardByShortId', () => {
+    it('should find the card by its board number, archived cards included', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({
+          data: [
+            { id: 'c41', idShort: 41 },
+            { id: 'c42', idShort: 42 },
+          ],
+        })
+        .mockResolvedValueOnce({ data: { id: 'c42', name: 'Fix login', idShort: 42 } });
+
+      const card = await createClient({ boardId: 'b1' }).getCardByShortId(undefined, 42);
+
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(1, '/boards/b1/cards', {
+        params: { fields: 'idShort', filter: 'all' },
+      });
+      expect(mockAxiosInstance.get.mock.calls[1][0]).toBe('/cards/c42');
+      expect(card).toMatchObject({ id: 'c42', name: 'Fix login' });
+    });
+
+    it('should report a number that is not on the board', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: [{ id: 'c1', idShort: 1 }] });
+
+      await expect(createClient({ boardId: 'b1' }).getCardByShortId(undefined, 7)).rejects.toThrow(
+        'Card #7 not found on this board'
+      );
+    });
+  });
+
+  describe('archived cards', () => {
+    it('getArchivedCards should list closed cards, most recent first, up to limit', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'old', name: 'Old', dateLastActivity: '2024-01-01T00:00:00.000Z', url: 'u1' },
+          { id: 'new', name: 'New', dateLastActivity: '2024-05-01T00:00:00.000Z', url: 'u2' },
+          { id: 'mid', name: 'Mid', dateLastActivity: '2024-03-01T00:00:00.000Z', url: 'u3' },
+        ],
+      });
+
+      const cards = await createClient().getArchivedCards('l1', 2);
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/lists/l1/cards', {
+        params: { filter: 'closed', fields: 'name,dateLastActivity,url' },
+      });
+      expect(cards.map(card => card.id)).toEqual(['new', 'mid']);
+    });
+
+    it('unarchiveCard should reopen the card', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1', closed: false } });
+
+      await createClient().unarchiveCard('c1');
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { closed: false });
+    });
+  });
+
+  describe('setCardDueReminder', () => {
+    it('should set the reminder and return it', async () => {
+      mockAxiosInstance.put.mockResolvedValue({
+        data: { id: 'c1', due: '2024-05-10T12:00:00.000Z', dueReminder: 1440 },
+      });
+
+      const result = await createClient().setCardDueReminder(undefined, 'c1', 1440);
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { dueReminder: 1440 });
+      expect(result).toEqual({
+        cardId: 'c1',
+        due: '2024-05-10T12:00:00.000Z',
+        dueReminder: 1440,
+      });
+    });
+
+    it('should warn when the card has no due date', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1', due: null, dueReminder: 60 } });
+
+      const result = await createClient().setCardDueReminder(undefined, 'c1', 60);
+
+      expect(result.warning).toContain('no due date');
+    });
+
+    it('should reject offsets Trello does not offer', async () => {
+      await expect(createClient().setCardDueReminder(undefined, 'c1', 30)).rejects.toThrow(
+        'Invalid reminderMinutes 30'
+      );
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('getCardLocationNames', () => {
+    it('should use names embedded in the card without extra requests', async () => {
+      const names = await createClient().getCardLocationNames({
+        idList: 'l1',
+        idBoard: 'b1',
+        list: { name: 'Doing' },
+        board: { name: 'Dev' },
+      });
+
+      expect(names).toEqual({ listName: 'Doing', boardName: 'Dev' });
+      expect(mockAxiosInstance.get).not.toHaveBeenCalled();
+    });
+
+    it('should look up missing names once per session', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => ({
+        data: url === '/lists/l1' ? { id: 'l1', name: 'Doing' } : { id: 'b1', name: 'Dev' },
+      }));
+
+      const client = createClient();
+      await client.getCardLocationNames({ idList: 'l1', idBoard: 'b1' });
+      const names = await client.getCardLocationNames({ idList: 'l1', idBoard: 'b1' });
+
+      expect(names).toEqual({ listName: 'Doing', boardName: 'Dev' });
+      expect(mockAxiosInstance.get).toHaveBeenCalledTimes(2);
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('getCardDescription', () => {
+    it('should fetch only the name and description', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'c1', name: 'Spec', desc: '## Goal\nShip it' },
+      });
+
+      const result = await createClient().getCardDescription('c1');
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'name,desc' },
+      });
+      expect(result).toEqual({ id: 'c1', name: 'Spec', desc: '## Goal\nShip it' });
+    });
+
+    it('should return an empty string for a card without a description', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', name: 'Spec' } });
+
+      const result = await createClient().getCardDescription('c1');
+
+      expect(result.desc).toBe('');
+    });
+  });
+
+  describe('getCardLabelsSummary', () => {
+    it('should return labels as color:name strings', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({
+        data: {
+          id: 'c1',
+          labels: [
+            { id: 'l1', name: 'Bug', color: 'red' },
+            { id: 'l2', name: '', color: 'green' },
+            { id: 'l3', name: 'Misc', color: null },
+          ],
+        },
+      });
+
+      const labels = await createClient().getCardLabelsSummary('c1');
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'labels' },
+      });
+      expect(labels).toEqual(['red:Bug', 'green', 'none:Misc']);
+    });
+
+    it('should return an empty array for an unlabeled card', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: { id: 'c1', labels: [] } });
+
+      await expect(createClient().getCardLabelsSummary('c1')).resolves.toEqual([]);
+    });
+  });
+
+  describe('getCardMemberDetails', () => {
+    it('should expand assignees and cache member lookups', async () => {
+      mockAxiosInstance.get.mockImplementation((url: string) => {
+        if (url.startsWith('/cards/')) {
+          return Promise.resolve({ data: { id: 'c1', idMembers: ['m1'] } });
+        }
+        return Promise.resolve({
+          data: { id: 'm1', username: 'amy', fullName: 'Amy Adams', avatarUrl: 'https://a/m1' },
+        });
+      });
+      const client = createClient();
+
+      const result = await client.getCardMemberDetails('c1');
+      await client.getCardMemberDetails('c1');
+
+      expect(result).toEqual({
+        members: [{ id: 'm1', username: 'amy', fullName: 'Amy Adams', avatarUrl: 'https://a/m1' }],
+        failures: [],
+      });
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/m1', {
+        params: { fields: 'id,username,fullName,avatarUrl' },
+      });
+      expect(
+        mockAxiosInstance.get.mock.calls.filter(([url]) => url === '/members/m1')
+      ).toHaveLength(1);
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should report failed member lookups instead of returning placeholders', async () => {
+      mockAxiosInstance.get.mockImplementation((url: string) => {
+        if (url.startsWith('/cards/')) {
+          return Promise.resolve({ data: { id: 'c1', idMembers: ['m1', 'm2'] } });
+        }
+        if (url === '/members/m2') {
+          return Promise.reject(new Error('network'));
+        }
+        return Promise.resolve({ data: { id: 'm1', username: 'amy', fullName: 'Amy Adams' } });
+      });
+
+      const result = await createClient().getCardMemberDetails('c1');
+
+      expect(result.members.map(member => member.id)).toEqual(['m1']);
+      expect(result.failures).toEqual([{ memberId: 'm2', error: expect.any(String) }]);
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('notifications', () => {
+    it('should fetch unread notifications of the requested types', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({
+        data: [
+          {
+            id: 'n1',
+            type: 'mentionedOnCard',
+            date: '2024-05-06T10:00:00.000Z',
+            unread: true,
+            data: { card: { id: 'c1', name: 'Fix login' }, board: { id: 'b1', name: 'Dev' } },
+          },
+        ],
+      });
+
+      const notifications = await createClient().getNotifications({
+        types: ['mentionedOnCard', 'cardDueSoon'],
+        readFilter: 'unread',
+        limit: 10,
+      });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/me/notifications', {
+        params: { read_filter: 'unread', limit: 10, filter: 'mentionedOnCard,cardDueSoon' },
+      });
+      expect(notifications[0]).toMatchObject({ id: 'n1', card: { id: 'c1' }, unread: true });
+    });
+
+    it('should mark a notification read', async () => {
+      mockAxiosInstance.put.mockResolvedValueOnce({
+        data: { id: 'n1', type: 'addedToCard', date: '2024-05-06T10:00:00.000Z', unread: false },
+      });
+
+      const notification = await createClient().markNotificationRead('n1');
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/notifications/n1/unread', {
+        value: false,
+      });
+      expect(notification.unread).toBe(false);
+    });
+
+    it('should count unread notifications before marking them all read', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: [{ id: 'n1' }, { id: 'n2' }] });
+      mockAxiosInstance.post.mockResolvedValueOnce({ data: {} });
+
+      const result = await createClient().markNotificationsRead();
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/notifications/all/read');
+      expect(result).toEqual({ marked: 2, failures: [] });
+    });
+
+    it('should mark only the given notifications when ids are passed', async () => {
+      mockAxiosInstance.put.mockResolvedValue({
+        data: { id: 'n1', type: 'addedToCard', date: '2024-05-06T10:00:00.000Z', unread: false },
+      });
+
+      const result = await createClient().markNotificationsRead({ ids: ['n1', 'n2'] });
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(2);
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+      expect(result.marked).toBe(2);
+    });
+  });
+
+  describe('searchCardsInBoard', () => {
+    it('should scope the search to the board and include list context', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: {
+          cards: [
+            {
+              id: 'c1',
+              name: 'Deploy API',
+              url: 'https://trello.com/c/abc',
+              due: null,
+              idList: 'l1',
+              list: { id: 'l1', name: 'Doing', pos: 2 },
+            },
+          ],
+        },
+      });
+
+      const client = createClient({ boardId: 'b1' });
+      const cards = await client.searchCardsInBoard({ query: 'deplo', partial: true, limit: 5 });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/search', {
+        params: expect.objectContaining({
+          query: 'deplo',
+          idBoards: 'b1',
+          modelTypes: 'cards',
+          cards_limit: 5,
+          card_list: true,
+          partial: true,
+        }),
+      });
+      expect(cards).toEqual([
+        {
+          id: 'c1',
+          name: 'Deploy API',
+          url: 'https://trello.com/c/abc',
+          due: null,
+          list: { id: 'l1', name: 'Doing' },
+        },
+      ]);
+    });
+  });
+
+  describe('findBoardCards', () => {
+    const cards = [
+      { id: 'c1', idMembers: ['m1'], due: '2000-01-01T00:00:00.000Z', dueComplete: false, idLabels: ['lr'] },
+      { id: 'c2', idMembers: [], due: '2000-01-01T00:00:00.000Z', dueComplete: true, idLabels: [] },
+      { id: 'c3', idMembers: [], due: null, dueComplete: false, idLabels: ['lr'] },
+      { id: 'c4', idMembers: ['m2'], due: '2999-01-01T00:00:00.000Z', dueComplete: false, idLabels: ['lb'] },
+    ];
+
+    it('should combine filters and fetch the fields they need', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: cards });
+
+      const client = createClient({ boardId: 'b1' });
+      const result = await client.findBoardCards({ fields: 'name', hasMembers: false, hasDue: true });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/cards', {
+        params: { fields: 'name,idMembers,due', filter: 'open' },
+      });
+      expect(result.map(card => card.id)).toEqual(['c2']);
+    });
+
+    it('should only count incomplete cards past due as overdue', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: cards });
+
+      const result = await createClient({ boardId: 'b1' }).findBoardCards({ overdue: true });
+
+      expect(result.map(card => card.id)).toEqual(['c1']);
+    });
+
+    it('should match every label of the requested color', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) =>
+        url === '/boards/b1/labels'
+          ? { data: [{ id: 'lr', name: 'Bug', color: 'red' }, { id: 'lb', name: 'Idea', color: 'blue' }] }
+          : { data: cards }
+      );
+
+      const result = await createClient({ boardId: 'b1' }).findBoardCards({ labelColor: 'Red' });
+
+      expect(result.map(card => card.id)).toEqual(['c1', 'c3']);
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('setCardLabels', () => {
+    const RED = 'a'.repeat(24);
+    const BLUE = 'b'.repeat(24);
+    const GREEN = 'c'.repeat(24);
+
+    it('should resolve colors and only add missing labels and remove extra ones', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => {
+        if (url === '/cards/c1') {
+          return { data: { id: 'c1', idBoard: 'b1', idLabels: [RED, BLUE] } };
+        }
+        return {
+          data: [
+            { id: RED, name: 'Bug', color: 'red' },
+            { id: GREEN, name: 'Done', color: 'green' },
+          ],
+        };
+      });
+      mockAxiosInstance.post.mockResolvedValue({ data: [] });
+      mockAxiosInstance.delete.mockResolvedValue({});
+
+      const client = createClient();
+      const changes = await client.setCardLabels('c1', [RED, 'green']);
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/cards/c1/idLabels', { value: GREEN });
+      expect(mockAxiosInstance.delete).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith(`/cards/c1/idLabels/${BLUE}`);
+      expect(changes).toEqual({ added: [GREEN], removed: [BLUE], unchanged: [RED] });
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should reject an unknown color before changing anything', async () => {
+      mockAxiosInstance.get
+        .mockResolvedValueOnce({ data: { id: 'c1', idBoard: 'b1', idLabels: [RED] } })
+        .mockResolvedValueOnce({ data: [{ id: RED, name: 'Bug', color: 'red' }] });
+
+      const client = createClient();
+      await expect(client.setCardLabels('c1', ['purple'])).rejects.toThrow(
+        'No label with color "purple"'
+      );
+      expect(mockAxiosInstance.delete).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('findCardsWithLabel / removeLabelFromCards', () => {
+    it('should find labelled cards across open and archived cards', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'c1', name: 'One', idLabels: ['lb1', 'lb2'] },
+          { id: 'c2', name: 'Two', idLabels: ['lb2'] },
+          { id: 'c3', name: 'Three', idLabels: ['lb1'], closed: true },
+        ],
+      });
+
+      const result = await createClient({ boardId: 'b1' }).findCardsWithLabel({ labelId: 'lb1' });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/cards', {
+        params: { fields: 'name,idLabels', filter: 'all' },
+      });
+      expect(result).toEqual({
+        labelId: 'lb1',
+        cards: [
+          { id: 'c1', name: 'One' },
+          { id: 'c3', name: 'Three' },
+        ],
+      });
+    });
+
+    it('should report per-card failures while removing the label', async () => {
+      mockAxiosInstance.delete.mockImplementation((url: string) =>
+        url.startsWith('/cards/c2') ? Promise.reject(new Error('boom')) : Promise.resolve({})
+      );
+
+      const result = await createClient().removeLabelFromCards('lb1', ['c1', 'c2']);
+
+      expect(mockAxiosInstance.delete).toHaveBeenCalledWith('/cards/c1/idLabels/lb1');
+      expect(result.removed).toEqual(['c1']);
+      expect(result.failures).toHaveLength(1);
+      expect(result.failures[0].cardId).toBe('c2');
+      mockAxiosInstance.delete.mockReset();
+    });
+  });
+
+  describe('setBoardClosed', () => {
+    it('should close the board and clear it as the default', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'b1', name: 'Old', closed: true } });
+      const client = createClient({ boardId: 'b1', defaultBoardId: 'b1' });
+
+      const result = await client.setBoardClosed('b1', true);
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/boards/b1/closed', { value: true });
+      expect(result.clearedDefault).toBe(true);
+      expect(client.effectiveDefaultBoardId).toBeUndefined();
+      expect(fsPromises.writeFile).toHaveBeenCalled();
+    });
+
+    it('should keep the default when another board is closed or reopened', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'b2', closed: false } });
+      const client = createClient({ boardId: 'b1' });
+
+      const result = await client.setBoardClosed('b2', false);
+
+      expect(result.clearedDefault).toBe(false);
+      expect(client.effectiveDefaultBoardId).toBe('b1');
+    });
+  });
+
+  describe('moveListToBoard', () => {
+    it('should move the list and remap labels by name', async () => {
+      mockAxiosInstance.get.mockImplementation((url: string) => {
+        if (url === '/lists/l1') {
+          return Promise.resolve({ data: { id: 'l1', name: 'Todo', idBoard: 'b1' } });
+        }
+        if (url === '/lists/l1/cards') {
+          return Promise.resolve({
+            data: [
+              { id: 'c1', idLabels: ['s-bug', 's-ux'], idMembers: [] },
+              { id: 'c2', idLabels: [], idMembers: ['m1'] },
+            ],
+          });
+        }
+        if (url === '/boards/b1/labels') {
+          return Promise.resolve({
+            data: [
+              { id: 's-bug', name: 'Bug', color: 'red' },
+              { id: 's-ux', name: 'UX', color: 'blue' },
+            ],
+          });
+        }
+        return Promise.resolve({ data: [{ id: 't-bug', name: 'bug', color: 'red' }] });
+      });
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'l1', name: 'Todo', idBoard: 'b2' } });
+
+      const result = await createClient().moveListToBoard({
+        listId: 'l1',
+        targetBoardId: 'b2',
+        position: 'top',
+        remapLabels: true,
+      });
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/lists/l1/idBoard', {
+        value: 'b2',
+        pos: 'top',
+      });
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1', { idLabels: 't-bug' });
+      expect(result.relabelled).toBe(1);
+      expect(result.warnings).toEqual([
+        'No label with the same name on board b2 for: UX',
+        '1 cards had members assigned; members who are not on board b2 were removed from them.',
+      ]);
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should refuse before moving when a label name matches several target labels', async () => {
+      mockAxiosInstance.get.mockImplementation((url: string) => {
+        if (url === '/lists/l1') {
+          return Promise.resolve({ data: { id: 'l1', name: 'Todo', idBoard: 'b1' } });
+        }
+        if (url === '/lists/l1/cards') {
+          return Promise.resolve({ data: [{ id: 'c1', idLabels: ['s-bug'], idMembers: [] }] });
+        }
+        if (url === '/boards/b1/labels') {
+          return Promise.resolve({ data: [{ id: 's-bug', name: 'Bug', color: 'red' }] });
+        }
+        return Promise.resolve({
+          data: [
+            { id: 't-ui', name: 'UI bug', color: 'red' },
+            { id: 't-api', name: 'API bug', color: 'orange' },
+          ],
+        });
+      });
+
+      const client = new TrelloClient({
+        apiKey: 'test-key',
+        token: 'test-token',
+        nameMatching: 'fuzzy',
+      });
+
+      await expect(
+        client.moveListToBoard({ listId: 'l1', targetBoardId: 'b2', remapLabels: true })
+      ).rejects.toThrow('Label name "Bug" is ambiguous');
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should refuse to move a list onto its own board', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: { id: 'l1', idBoard: 'b1' } });
+
+      await expect(
+        createClient().moveListToBoard({ listId: 'l1', targetBoardId: 'b1' })
+      ).rejects.toThrow('List l1 is already on board b1');
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('getCardCountByLabel', () => {
+    it('should cross-tabulate open cards by list and label', async () => {
+      mockAxiosInstance.get.mockImplementation((url: string) => {
+        if (url.endsWith('/lists')) {
+          return Promise.resolve({
+            data: [
+              { id: 'l1', name: 'Todo' },
+              { id: 'l2', name: 'Done' },
+            ],
+          });
+        }
+        if (url.endsWith('/labels')) {
+          return Promise.resolve({
+            data: [
+              { id: 'lb1', name: 'bug', color: 'red' },
+              { id: 'lb2', name: '', color: 'green' },
+            ],
+          });
+        }
+        return Promise.resolve({
+          data: [
+            { id: 'c1', idList: 'l1', idLabels: ['lb1', 'lb2'] },
+            { id: 'c2', idList: 'l1', idLabels: ['lb1'] },
+            { id: 'c3', idList: 'l2', idLabels: [] },
+          ],
+        });
+      });
+
+      const report = await createClient({ boardId: 'b1' }).getCardCountByLabel();
+
+      expect(report).toEqual({
+        lists: [
+          { listId: 'l1', name: 'Todo', total: 2, unlabeled: 0, labels: { bug: 2, green: 1 } },
+          { listId: 'l2', name: 'Done', total: 1, unlabeled: 1, labels: {} },
+        ],
+        totals: { bug: 2, green: 1 },
+      });
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b1/cards', {
+        params: { fields: 'idLabels,idList', filter: 'open' },
+      });
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('renameAttachment', () => {
+    it('should PUT only the trimmed name', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'a1', name: 'Spec' } });
+
+      const attachment = await createClient().renameAttachment(undefined, 'c1', 'a1', ' Spec ');
+
+      expect(attachment.name).toBe('Spec');
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c1/attachments/a1', {
+        name: 'Spec',
+      });
+    });
+
+    it('should explain when Trello refuses to rename an uploaded file', async () => {
+      vi.mocked(axios.isAxiosError).mockReturnValue(true);
+      try {
+        mockAxiosInstance.put.mockRejectedValue({ response: { status: 400 }, message: 'Bad' });
+        mockAxiosInstance.get.mockResolvedValue({
+          data: { id: 'a1', isUpload: true, fileName: 'report.pdf' },
+        });
+
+        await expect(
+          createClient().renameAttachment(undefined, 'c1', 'a1', 'Report')
+        ).rejects.toThrow('Trello rejected renaming report.pdf');
+      } finally {
+        vi.mocked(axios.isAxiosError).mockReturnValue(false);
+        mockAxiosInstance.put.mockReset();
+        mockAxiosInstance.get.mockReset();
+      }
+    });
+  });
+
+  describe('setLabelColor', () => {
+    it('should resolve a label by name and PUT only the color', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'l1', name: 'Bug', color: 'red' },
+          { id: 'l2', name: 'Feature', color: 'green' },
+        ],
+      });
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'l1', name: 'Bug', color: 'orange' } });
+
+      const label = await createClient({ boardId: 'b1' }).setLabelColor({
+        label: 'bug',
+        color: 'Orange',
+      });
+
+      expect(label.color).toBe('orange');
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/labels/l1', { color: 'orange' });
+    });
+
+    it('should reject colors outside the palette without calling Trello', async () => {
+      await expect(
+        createClient().setLabelColor({ label: 'aaaaaaaaaaaaaaaaaaaaaaaa', color: 'teal' })
+      ).rejects.toThrow('Invalid label color "teal"');
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('normalizeListPositions', () => {
+    beforeEach(() => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'c2', name: 'Second', pos: 98765.4321 },
+          { id: 'c1', name: 'First', pos: 65536 },
+          { id: 'c3', name: 'Third', pos: 1e9 },
+        ],
+      });
+    });
+
+    it('should keep order and update only cards whose position changes', async () => {
+      mockAxiosInstance.put.mockResolvedValue({ data: {} });
+
+      const result = await createClient().normalizeListPositions({ listId: 'l1' });
+
+      expect(result).toEqual({
+        changes: [
+          { id: 'c2', name: 'Second', before: 98765.4321, after: 131072 },
+          { id: 'c3', name: 'Third', before: 1e9, after: 196608 },
+        ],
+        failures: [],
+      });
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(2);
+      expect(mockAxiosInstance.put).toHaveBeenCalledWith('/cards/c3', { pos: 196608 });
+    });
+
+    it('should not write anything on a dry run', async () => {
+      const result = await createClient().normalizeListPositions({ listId: 'l1', dryRun: true });
+
+      expect(result.changes).toHaveLength(2);
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('getListCardsCount', () => {
+    it('should count open cards fetching only IDs', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: [{ id: 'c1' }, { id: 'c2' }] });
+
+      const count = await createClient().getListCardsCount('l1');
+
+      expect(count).toBe(2);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/lists/l1/cards', {
+        params: { fields: 'id', filter: 'open' },
+      });
+    });
+
+    it('should include archived cards and reject a list on another board', async () => {
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: { id: 'l1', idBoard: 'b1' } });
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: [{ id: 'c1' }] });
+      const client = createClient();
+
+      await expect(
+        client.getListCardsCount('l1', { boardId: 'b1', includeClosed: true })
+      ).resolves.toBe(1);
+      expect(mockAxiosInstance.get).toHaveBeenLastCalledWith('/lists/l1/cards', {
+        params: { fields: 'id', filter: 'all' },
+      });
+
+      mockAxiosInstance.get.mockResolvedValueOnce({ data: { id: 'l1', idBoard: 'b1' } });
+      await expect(client.getListCardsCount('l1', { boardId: 'b2' })).rejects.toThrow(
+        'belongs to board b1'
+      );
+    });
+  });
+
+  describe('createCardsBulk', () => {
+    it('should create cards across lists and report failures per card', async () => {
+      mockAxiosInstance.post.mockImplementation(async (_url: string, body: { name: string }) => {
+        if (body.name === 'Broken') throw new Error('API Error');
+        return { data: { id: `id-${body.name}`, name: body.name } };
+      });
+
+      const client = createClient();
+      const results = await client.createCardsBulk([
+        { listId: 'l1', name: 'First', members: ['m1'] },
+        { listId: 'l2', name: 'Broken' },
+        { listId: 'l2', name: 'Third', desc: 'Desc', due: '2024-06-01T00:00:00.000Z' },
+      ]);
+
+      expect(results).toEqual([
+        {
+          index: 0,
+          name: 'First',
+          success: true,
+          id: 'id-First',
+          card: { id: 'id-First', name: 'First' },
+        },
+        { index: 1, name: 'Broken', success: false, error: expect.any(String) },
+        {
+          index: 2,
+          name: 'Third',
+          success: true,
+          id: 'id-Third',
+          card: { id: 'id-Third', name: 'Third' },
+        },
+      ]);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards',
+        expect.objectContaining({ idList: 'l1', name: 'First', idMembers: ['m1'] })
+      );
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards',
+        expect.objectContaining({ idList: 'l2', desc: 'Desc', due: '2024-06-01T00:00:00.000Z' })
+      );
+      mockAxiosInstance.post.mockReset();
+    });
+
+    it('should keep at most the requested number of requests in flight', async () => {
+      let active = 0;
+      let peak = 0;
+      mockAxiosInstance.post.mockImplementation(async (_url: string, body: { name: string }) => {
+        active++;
+        peak = Math.max(peak, active);
+        await new Promise(resolve => setTimeout(resolve, 5));
+        active--;
+        return { data: { id: `id-${body.name}` } };
+      });
+
+      const cards = Array.from({ length: 12 }, (_, i) => ({ listId: 'l1', name: `Card ${i}` }));
+      const results = await createClient().createCardsBulk(cards, 4);
+
+      expect(results.every(result => result.success)).toBe(true);
+      expect(peak).toBe(4);
+      mockAxiosInstance.post.mockReset();
+    });
+
+    it('should reject a concurrency above the maximum', async () => {
+      await expect(
+        createClient().createCardsBulk([{ listId: 'l1', name: 'Card' }], 11)
+      ).rejects.toThrow('concurrency must be an integer from 1 to 10');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+
+    it('should reject more cards than the batch limit', async () => {
+      const cards = Array.from({ length: TrelloClient.BATCH_ADD_CARDS_LIMIT + 1 }, (_, i) => ({
+        listId: 'l1',
+        name: `Card ${i}`,
+      }));
+      await expect(createClient().createCardsBulk(cards)).rejects.toThrow('Cannot create more than');
+      expect(mockAxiosInstance.post).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('importBoard', () => {
+    const doc = {
+      version: 1,
+      board: { id: 'old-b', name: 'Source', desc: '' },
+      labels: [{ id: 'old-l1', name: 'Bug', color: 'red' }],
+      members: [],
+      lists: [{ id: 'old-list', name: 'Todo', closed: false, pos: 1 }],
+      cards: [
+        {
+          id: 'old-c1',
+          idList: 'old-list',
+          name: 'Card',
+          desc: '',
+          closed: false,
+          pos: 1,
+          due: null,
+          start: null,
+          dueComplete: false,
+          idLabels: ['old-l1'],
+          idMembers: ['m1'],
+          checklists: [
+            {
+              id: 'old-ck',
+              name: 'Steps',
+              pos: 1,
+              items: [
+                {
+                  id: 'old-i1',
+                  name: 'one',
+                  state: 'complete' as const,
+                  pos: 1,
+                  due: null,
+                  idMember: null,
+                },
+              ],
+            },
+          ],
+          attachments: [
+            { id: 'old-a1', name: 'spec.pdf', url: 'https://x/spec.pdf', isUpload: true },
+            { id: 'old-a2', name: 'Docs', url: 'https://docs.example.com', isUpload: false },
+          ],
+        },
+        {
+          id: 'old-c2',
+          idList: 'gone',
+          name: 'Orphan',
+          desc: '',
+          closed: false,
+          pos: 2,
+          due: null,
+          start: null,
+          dueComplete: false,
+          idLabels: [],
+          idMembers: [],
+          checklists: [],
+          attachments: [],
+        },
+      ],
+    };
+
+    it('should map old IDs to new ones and report skipped items', async () => {
+      mockAxiosInstance.post.mockImplementation(async (url: string) => {
+        const ids: Record<string, string> = {
+          '/boards': 'new-b',
+          '/boards/new-b/labels': 'new-l1',
+          '/lists': 'new-list',
+          '/cards': 'new-c1',
+          '/cards/new-c1/checklists': 'new-ck',
+        };
+        return { data: { id: ids[url] ?? 'other' } };
+      });
+
+      const summary = await createClient().importBoard(doc, { name: 'Copy' });
+
+      expect(summary).toEqual({
+        boardId: 'new-b',
+        created: { labels: 1, lists: 1, cards: 1, checklists: 1, checkItems: 1, attachments: 1 },
+        reusedLabels: 0,
+        skipped: [
+          {
+            type: 'attachment',
+            id: 'old-a1',
+            name: 'spec.pdf',
+            reason: 'uploaded file must be re-uploaded',
+          },
+          {
+            type: 'members',
+            id: 'old-c1',
+            name: 'Card',
+            reason: '1 member assignment(s) not imported',
+          },
+          { type: 'card', id: 'old-c2', name: 'Orphan', reason: 'its list was not imported' },
+        ],
       });
-      expect(result).toEqual(checklist);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/boards',
+        expect.objectContaining({ name: 'Copy', defaultLabels: false, defaultLists: false })
+      );
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards',
+        expect.objectContaining({ idList: 'new-list', idLabels: 'new-l1' })
+      );
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/checklists/new-ck/checkItems', {
+        name: 'one',
+        checked: true,
+        pos: 'bottom',
+      });
+      mockAxiosInstance.post.mockReset();
     });
 
-    it('should allow overriding name', async () => {
-      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'cl2' } });
-
-      const client = createClient();
-      await client.copyChecklist({
-        sourceChecklistId: 'cl1',
-        cardId: 'c2',
-        name: 'Renamed Checklist',
-        pos: 'top',
+    it('should reuse matching labels on an existing target board', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [{ id: 'existing-l', name: 'Bug', color: 'red' }],
       });
+      mockAxiosInstance.post.mockResolvedValue({ data: { id: 'new-id' } });
 
-      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/checklists', {
-        idCard: 'c2',
-        idChecklistSource: 'cl1',
-        name: 'Renamed Checklist',
-        pos: 'top',
+      const summary = await createClient().importBoard(
+        { ...doc, cards: [] },
+        { targetBoardId: 'target' }
+      );
+
+      expect(summary.boardId).toBe('target');
+      expect(summary.reusedLabels).toBe(1);
+      expect(summary.created.labels).toBe(0);
+      expect(mockAxiosInstance.post).not.toHaveBeenCalledWith('/boards', expect.anything());
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith('/lists', {
+        name: 'Todo',
+        idBoard: 'target',
+        pos: 'bottom',
       });
     });
   });
 
   describe('batchAddCards', () => {
-    it('should create multiple cards sequentially', async () => {
+    it('should create multiple cards in input order', async () => {
       mockAxiosInstance.post
         .mockResolvedValueOnce({ data: { id: 'c1', name: 'Card 1' } })
         .mockResolvedValueOnce({ data: { id: 'c2', name: 'Card 2' } })
@@ -721,9 +3180,33 @@ describe('TrelloClient', () => {
       ]);
 
       expect(created).toHaveLength(2);
-      expect(errors).toHaveLength(1);
-      expect(errors[0].index).toBe(1);
-      expect(errors[0].name).toBe('Card 2');
+      expect(errors).toEqual([{ index: 1, name: 'Card 2', error: expect.any(String) }]);
+    });
+
+    it('should create every card in the given list', async () => {
+      mockAxiosInstance.post.mockImplementation(async (_url: string, body: { name: string }) => ({
+        data: { id: body.name, name: body.name },
+      }));
+
+      await createClient().batchAddCards('l1', [
+        { name: 'Card 1', dueDate: '2024-06-01T00:00:00.000Z', start: '2024-05-01' },
+        { name: 'Card 2' },
+      ]);
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards',
+        expect.objectContaining({
+          idList: 'l1',
+          name: 'Card 1',
+          due: '2024-06-01T00:00:00.000Z',
+          start: '2024-05-01',
+        })
+      );
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards',
+        expect.objectContaining({ idList: 'l1', name: 'Card 2' })
+      );
+      mockAxiosInstance.post.mockReset();
     });
 
     it('should reject when exceeding card limit', async () => {
@@ -731,6 +3214,25 @@ describe('TrelloClient', () => {
       const tooMany = Array.from({ length: 51 }, (_, i) => ({ name: `Card ${i}` }));
       await expect(client.batchAddCards('l1', tooMany)).rejects.toThrow('Cannot create more than 50');
     });
+
+    it('should keep at most concurrency cards in flight', async () => {
+      let inFlight = 0;
+      let peak = 0;
+      mockAxiosInstance.post.mockImplementation(async (_url: string, body: { name: string }) => {
+        peak = Math.max(peak, ++inFlight);
+        await new Promise(resolve => setTimeout(resolve, 5));
+        inFlight--;
+        return { data: { id: body.name, name: body.name } };
+      });
+
+      const cards = Array.from({ length: 6 }, (_, i) => ({ name: `Card ${i}` }));
+      const { created } = await createClient().batchAddCards('l1', cards, 2);
+
+      expect(peak).toBe(2);
+      expect(created.map(card => card.name)).toEqual(cards.map(card => card.name));
+      await expect(createClient().batchAddCards('l1', cards, 0)).rejects.toThrow('concurrency');
+      mockAxiosInstance.post.mockReset();
+    });
   });
 
   describe('getCard', () => {
@@ -750,6 +3252,52 @@ describe('TrelloClient', () => {
         }),
       });
     });
+
+    it('should leave out resources whose expansion is disabled', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', name: 'Card' } });
+
+      const client = createClient();
+      await client.getCard('c1', false, { members: false, checklists: false, customFields: false });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: expect.objectContaining({
+          attachments: true,
+          checklists: 'none',
+          checkItemStates: false,
+          members: false,
+          membersVoted: false,
+          customFieldItems: false,
+        }),
+      });
+    });
+  });
+
+  describe('lookups', () => {
+    it('getCardById should pass requested fields', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'c1', name: 'Card' } });
+
+      const client = createClient();
+      await client.getCardById('c1', 'name,closed');
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1', {
+        params: { fields: 'name,closed' },
+      });
+    });
+
+    it('getLabel, getList, getAction and getChecklistItem should hit their endpoints', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: {} });
+
+      const client = createClient();
+      await client.getLabel('lab1');
+      await client.getList('l1');
+      await client.getAction('a1');
+      await client.getChecklistItem('c1', 'ci1');
+
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(1, '/labels/lab1');
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(2, '/lists/l1');
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(3, '/actions/a1');
+      expect(mockAxiosInstance.get).toHaveBeenNthCalledWith(4, '/cards/c1/checkItem/ci1');
+    });
   });
 
   describe('getCardHistory', () => {
@@ -764,6 +3312,17 @@ describe('TrelloClient', () => {
       });
     });
 
+    it('should pass before for cursor paging', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: [] });
+
+      const client = createClient();
+      await client.getCardHistory('c1', undefined, 10, 'a5');
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1/actions', {
+        params: { limit: 10, before: 'a5' },
+      });
+    });
+
     it('should fetch without optional params', async () => {
       mockAxiosInstance.get.mockResolvedValue({ data: [] });
 
@@ -776,6 +3335,29 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('getCardActionsSummary', () => {
+    it('should fetch the default action types and summarize each one', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          {
+            type: 'commentCard',
+            date: '2024-05-02T00:00:00.000Z',
+            data: { text: 'Looks good' },
+            memberCreator: { id: 'm1', fullName: 'Jane' },
+          },
+        ],
+      });
+
+      const client = createClient();
+      const timeline = await client.getCardActionsSummary('c1', 5);
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/cards/c1/actions', {
+        params: { filter: expect.stringContaining('updateCard'), limit: 5 },
+      });
+      expect(timeline).toEqual(['2024-05-02 Jane commented: "Looks good"']);
+    });
+  });
+
   describe('attachDataToCard', () => {
     const attachment = { id: 'a1', name: 'notes.md' };
 
@@ -900,6 +3482,414 @@ describe('TrelloClient', () => {
     });
   });
 
+  describe('default fields', () => {
+    it('loadConfig should expose validated per-tool default fields', async () => {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ defaultFields: { get_cards_by_list_id: 'name, due' } })
+      );
+
+      const client = createClient();
+      await client.loadConfig();
+
+      expect(client.getDefaultFields('get_cards_by_list_id')).toBe('name,due');
+      expect(client.getDefaultFields('get_lists')).toBeUndefined();
+    });
+
+    it('loadConfig should reject unknown field names', async () => {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ defaultFields: { get_cards_by_list_id: 'bogus' } })
+      );
+
+      const client = createClient();
+      await expect(client.loadConfig()).rejects.toThrow('unknown card fields: bogus');
+    });
+  });
+
+  describe('duplicateCardToLists', () => {
+    it('should copy the card into every list and report failures per list', async () => {
+      mockAxiosInstance.post.mockImplementation(async (_url: string, body: { idList: string }) => {
+        if (body.idList === 'l2') throw new Error('list not found');
+        return { data: { id: `copy-${body.idList}` } };
+      });
+
+      const result = await createClient().duplicateCardToLists({
+        sourceCardId: 'c1',
+        listIds: ['l1', 'l2', 'l3', 'l1'],
+        keepFromSource: 'checklists',
+      });
+
+      expect(mockAxiosInstance.post).toHaveBeenCalledTimes(3);
+      expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+        '/cards',
+        expect.objectContaining({ idCardSource: 'c1', idList: 'l1', keepFromSource: 'checklists' })
+      );
+      expect(result).toEqual({
+        created: { l1: 'copy-l1', l3: 'copy-l3' },
+        failures: [{ listId: 'l2', error: expect.stringContaining('unexpected error') }],
+      });
+      mockAxiosInstance.post.mockReset();
+    });
+  });
+
+  describe('default list', () => {
+    it('setDefaultList should record the list for its board and persist on request', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'l1', name: 'Inbox', idBoard: 'b1', closed: false },
+      });
+
+      const client = createClient({ boardId: 'b1' });
+      await client.setDefaultList({ listId: 'l1', persist: true });
+
+      expect(await client.resolveDefaultListId()).toBe('l1');
+      const saved = JSON.parse(vi.mocked(fsPromises.writeFile).mock.calls[0][1] as string);
+      expect(saved.defaultLists).toEqual({ b1: 'l1' });
+    });
+
+    it('setDefaultList should keep session-only defaults out of later saves', async () => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => ({
+        data:
+          url === '/lists/l2'
+            ? { id: 'l2', name: 'Triage', idBoard: 'b2', closed: false }
+            : { id: 'l1', name: 'Inbox', idBoard: 'b1', closed: false },
+      }));
+
+      const client = createClient({ boardId: 'b1' });
+      await client.setDefaultList({ listId: 'l2' });
+      await client.setDefaultList({ listId: 'l1', persist: true });
+
+      expect(await client.resolveDefaultListId('b2')).toBe('l2');
+      const saved = JSON.parse(vi.mocked(fsPromises.writeFile).mock.calls[0][1] as string);
+      expect(saved.defaultLists).toEqual({ b1: 'l1' });
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('loadConfig should reject a defaultLists section that is not board IDs to list IDs', async () => {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ defaultLists: { b1: { id: 'l1' } } })
+      );
+
+      await expect(createClient().loadConfig()).rejects.toThrow(
+        'defaultLists."b1" must be a list ID string'
+      );
+    });
+
+    it('setDefaultList should reject a list on another board or an archived list', async () => {
+      const client = createClient();
+      mockAxiosInstance.get.mockResolvedValueOnce({
+        data: { id: 'l1', name: 'Inbox', idBoard: 'b2', closed: false },
+      });
+      await expect(client.setDefaultList({ listId: 'l1', boardId: 'b1' })).rejects.toThrow(
+        'is on board b2, not b1'
+      );
+      mockAxiosInstance.get.mockResolvedValueOnce({
+        data: { id: 'l1', name: 'Inbox', idBoard: 'b1', closed: true },
+      });
+      await expect(client.setDefaultList({ listId: 'l1' })).rejects.toThrow('is archived');
+    });
+
+    it('getDefaultList should warn when the list was archived after being set', async () => {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ defaultLists: { b1: 'l1' } })
+      );
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'l1', name: 'Inbox', idBoard: 'b1', closed: true },
+      });
+
+      const client = createClient({ boardId: 'b1' });
+      await client.loadConfig();
+
+      expect(await client.getDefaultList()).toMatchObject({
+        list: { id: 'l1', closed: true },
+        warning: expect.stringContaining('has been archived'),
+      });
+      await expect(client.resolveDefaultListId()).rejects.toThrow('has been archived');
+    });
+
+    it('resolveDefaultListId should ask for listId when the board has no default list', async () => {
+      await expect(createClient({ boardId: 'b1' }).resolveDefaultListId()).rejects.toThrow(
+        'listId is required when the board has no default list'
+      );
+    });
+  });
+
+  describe('readBoardExport', () => {
+    it('should refuse paths outside the export directory', async () => {
+      const client = createClient();
+
+      await expect(client.readBoardExport('/etc/passwd')).rejects.toThrow(
+        'Export file must be inside the export directory'
+      );
+      await expect(client.readBoardExport('../config.json')).rejects.toThrow(
+        'Export file must be inside the export directory'
+      );
+      expect(fsPromises.readFile).not.toHaveBeenCalled();
+    });
+
+    it('should refuse a symlink inside the export directory that points outside it', async () => {
+      vi.mocked(fsPromises.realpath).mockImplementation((async (p: string) =>
+        p.endsWith('link.json') ? '/etc/passwd' : p) as typeof fsPromises.realpath);
+
+      await expect(createClient().readBoardExport('link.json')).rejects.toThrow(
+        'Export file must be inside the export directory'
+      );
+      expect(fsPromises.readFile).not.toHaveBeenCalled();
+      vi.mocked(fsPromises.realpath).mockReset();
+    });
+  });
+
+  describe('WIP limits', () => {
+    async function clientWithLimits() {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ wipLimits: { doing: 2 } })
+      );
+      const client = createClient();
+      await client.loadConfig();
+      return client;
+    }
+
+    function mockBoard(cardsInTarget: number) {
+      mockAxiosInstance.get.mockImplementation(async (url: string) => {
+        if (url === '/cards/c1') return { data: { idBoard: 'b1', idList: 'l1', pos: 1 } };
+        if (url === '/lists/l2') return { data: { id: 'l2', name: 'Doing' } };
+        return { data: Array.from({ length: cardsInTarget }, (_, i) => ({ id: `x${i}` })) };
+      });
+    }
+
+    it('moveCard should refuse a move into a list at its limit', async () => {
+      const client = await clientWithLimits();
+      mockBoard(2);
+
+      await expect(client.moveCard(undefined, 'c1', 'l2')).rejects.toThrow(
+        'List "Doing" is at its WIP limit (2 of 2 cards)'
+      );
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('moveCard should move when under the limit or overridden', async () => {
+      const client = await clientWithLimits();
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1', idList: 'l2' } });
+
+      mockBoard(1);
+      await client.moveCard(undefined, 'c1', 'l2');
+      mockBoard(5);
+      await client.moveCard(undefined, 'c1', 'l2', undefined, { override: true });
+
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(2);
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('loadConfig should reject an invalid wipLimits section', async () => {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ wipLimits: { doing: -1 } })
+      );
+
+      await expect(createClient().loadConfig()).rejects.toThrow(
+        'wipLimits."doing" must be a non-negative integer'
+      );
+    });
+
+    it('loadConfig should still apply wipLimits when another section is invalid', async () => {
+      vi.mocked(fsPromises.readFile).mockResolvedValueOnce(
+        JSON.stringify({ defaultFields: { get_lists: 'bogus' }, wipLimits: { doing: 2 } })
+      );
+      const client = createClient();
+      await expect(client.loadConfig()).rejects.toThrow('unknown card fields: bogus');
+      mockBoard(2);
+
+      await expect(client.moveCard(undefined, 'c1', 'l2')).rejects.toThrow('WIP limit');
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('patchCard should apply the limit to idList moves unless overridden', async () => {
+      const client = await clientWithLimits();
+      mockAxiosInstance.put.mockResolvedValue({ data: { id: 'c1', idList: 'l2' } });
+      mockBoard(2);
+
+      await expect(client.patchCard('c1', { idList: 'l2' })).rejects.toThrow(
+        'List "Doing" is at its WIP limit (2 of 2 cards)'
+      );
+      expect(mockAxiosInstance.put).not.toHaveBeenCalled();
+
+      await client.patchCard('c1', { idList: 'l2' }, { override: true });
+      await client.patchCard('c1', { idList: 'l1', name: 'Same list' });
+      expect(mockAxiosInstance.put).toHaveBeenCalledTimes(2);
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('whoami', () => {
+    it('should fetch the authenticated member once per session', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: { id: 'm1', username: 'jane', fullName: 'Jane Doe', email: 'jane@example.com' },
+      });
+
+      const client = createClient();
+      const first = await client.whoami();
+      const second = await client.whoami();
+
+      expect(first).toEqual({
+        id: 'm1',
+        username: 'jane',
+        fullName: 'Jane Doe',
+        email: 'jane@example.com',
+      });
+      expect(second).toBe(first);
+      expect(mockAxiosInstance.get).toHaveBeenCalledTimes(1);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/me', {
+        params: { fields: 'id,username,fullName,email' },
+      });
+    });
+
+    it('should retry after a failed lookup', async () => {
+      mockAxiosInstance.get
+        .mockRejectedValueOnce(new Error('network'))
+        .mockResolvedValueOnce({ data: { id: 'm1', username: 'jane', fullName: 'Jane' } });
+
+      const client = createClient();
+      await expect(client.whoami()).rejects.toThrow();
+      await expect(client.whoami()).resolves.toMatchObject({ id: 'm1', email: null });
+    });
+  });
+
+  describe('getMemberBoards', () => {
+    it('should fetch boards for a username with filter and fields', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: [{ id: 'b1', name: 'Roadmap' }] });
+
+      const boards = await createClient().getMemberBoards('@jane', {
+        filter: 'open',
+        fields: 'name',
+      });
+
+      expect(boards).toEqual([{ id: 'b1', name: 'Roadmap' }]);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/jane/boards', {
+        params: { filter: 'open', fields: 'name' },
+      });
+    });
+
+    it('should keep only boards in allowed workspaces', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'b1', idOrganization: 'w1' },
+          { id: 'b2', idOrganization: 'w2' },
+        ],
+      });
+
+      const client = createClient({ allowedWorkspaceIds: ['w1'] });
+      const boards = await client.getMemberBoards('m1', { fields: 'name' });
+
+      expect(boards.map(board => board.id)).toEqual(['b1']);
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/members/m1/boards', {
+        params: { fields: 'name,idOrganization' },
+      });
+    });
+  });
+
+  describe('getBoardByName', () => {
+    beforeEach(() => {
+      mockAxiosInstance.get.mockImplementation(async (url: string) =>
+        url === '/members/me/organizations'
+          ? {
+              data: [
+                { id: 'w1', name: 'eng', displayName: 'Engineering' },
+                { id: 'w2', name: 'mkt', displayName: 'Marketing' },
+              ],
+            }
+          : {
+              data: [
+                { id: 'b1', name: 'Roadmap', closed: false, idOrganization: 'w1', url: 'u1' },
+                { id: 'b2', name: 'roadmap', closed: false, idOrganization: 'w2', url: 'u2' },
+                { id: 'b3', name: 'Roadmap', closed: true, idOrganization: 'w2', url: 'u3' },
+              ],
+            }
+      );
+    });
+
+    it('should return candidates with workspace names when the name is ambiguous', async () => {
+      const result = await createClient().getBoardByName('Roadmap');
+      expect(result).toEqual({
+        board: null,
+        candidates: [
+          { id: 'b1', name: 'Roadmap', url: 'u1', workspaceId: 'w1', workspaceName: 'Engineering' },
+          { id: 'b2', name: 'roadmap', url: 'u2', workspaceId: 'w2', workspaceName: 'Marketing' },
+        ],
+      });
+      mockAxiosInstance.get.mockReset();
+    });
+
+    it('should disambiguate by workspace name or ID', async () => {
+      const client = createClient();
+      const byName = await client.getBoardByName('roadmap', 'marketing');
+      expect(byName).toMatchObject({ board: { id: 'b2' }, workspaceName: 'Marketing' });
+      const byId = await client.getBoardByName('Roadmap', 'w1');
+      expect(byId).toMatchObject({ board: { id: 'b1' } });
+      await expect(client.getBoardByName('Roadmap', 'Sales')).rejects.toThrow(
+        'Workspace "Sales" not found'
+      );
+      mockAxiosInstance.get.mockReset();
+    });
+  });
+
+  describe('setDefaultBoard', () => {
+    it('should resolve a board by name and keep it in memory only', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'b1', name: 'Engineering', closed: false },
+          { id: 'b2', name: 'Marketing', closed: false },
+        ],
+      });
+
+      const client = createClient({ boardId: 'b1' });
+      const board = await client.setDefaultBoard({ boardName: 'marketing' });
+
+      expect(board.id).toBe('b2');
+      expect(client.effectiveDefaultBoardId).toBe('b2');
+      expect(fsPromises.writeFile).not.toHaveBeenCalled();
+    });
+
+    it('should write the config file when persist is set', async () => {
+      mockAxiosInstance.get.mockResolvedValue({ data: { id: 'b2', name: 'Marketing' } });
+
+      const client = createClient();
+      await client.setDefaultBoard({ boardId: 'b2', persist: true });
+
+      expect(mockAxiosInstance.get).toHaveBeenCalledWith('/boards/b2');
+      expect(fsPromises.writeFile).toHaveBeenCalledWith(
+        expect.stringContaining('config.json'),
+        expect.stringContaining('"boardId": "b2"')
+      );
+    });
+
+    it('should reject ambiguous board names', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [
+          { id: 'b1', name: 'Sprint', closed: false },
+          { id: 'b2', name: 'Sprint', closed: false },
+        ],
+      });
+
+      const client = createClient();
+      await expect(client.setDefaultBoard({ boardName: 'Sprint' })).rejects.toThrow('ambiguous');
+    });
+
+    it('should honor the configured name matching mode', async () => {
+      mockAxiosInstance.get.mockResolvedValue({
+        data: [{ id: 'b1', name: 'Engineering', closed: false }],
+      });
+
+      const client = new TrelloClient({
+        apiKey: 'test-key',
+        token: 'test-token',
+        nameMatching: 'exact',
+      });
+
+      await expect(client.setDefaultBoard({ boardName: 'engineering' })).rejects.toThrow(
+        'Board "engineering" not found'
+      );
+      expect((await client.setDefaultBoard({ boardName: 'Engineering' })).id).toBe('b1');
+    });
+  });
+
   describe('Config persistence', () => {
     it('activeBoardId should return configured board', () => {
       const client = createClient({ boardId: 'b1' });
@@ -0,0 +1,117 @@
+import { describe, it, expect } from 'vitest';
+import { buildListTimeline, summarizeAction } from '../../../src/trello/actions.js';
+import { TrelloAction } from '../../../src/types.js';
+
+function action(type: string, data: Partial<TrelloAction['data']>, extra: Partial<TrelloAction> = {}) {
+  return {
+    id: 'a1',
+    idMemberCreator: 'm1',
+    type,
+    date: '2024-05-01T12:34:56.000Z',
+    data: { board: { id: 'b1', name: 'Board' }, ...data },
+    memberCreator: { id: 'm1', fullName: 'Jane', username: 'jane' },
+    ...extra,
+  } as TrelloAction;
+}
+
+describe('summarizeAction', () => {
+  it('summarizes list moves', () => {
+    expect(
+      summarizeAction(
+        action('updateCard', {
+          listBefore: { id: 'l1', name: 'Doing' },
+          listAfter: { id: 'l2', name: 'Done' },
+          old: { idList: 'l1' },
+        })
+      )
+    ).toBe('2024-05-01 Jane moved from Doing to Done');
+  });
+
+  it('summarizes due date changes', () => {
+    expect(
+      summarizeAction(
+        action('updateCard', {
+          card: { id: 'c1', name: 'Card', due: '2024-06-01T00:00:00.000Z' },
+          old: { due: null },
+        })
+      )
+    ).toBe('2024-05-01 Jane set due date to 2024-06-01');
+    expect(
+      summarizeAction(action('updateCard', { card: { id: 'c1', name: 'Card', due: null }, old: { due: 'x' } }))
+    ).toBe('2024-05-01 Jane removed the due date');
+  });
+
+  it('summarizes labels and members', () => {
+    expect(
+      summarizeAction(action('addLabelToCard', { label: { id: 'x', name: 'Bug', color: 'red' } }))
+    ).toBe('2024-05-01 Jane added label "Bug"');
+    expect(summarizeAction(action('addMemberToCard', { idMember: 'm1' }))).toBe(
+      '2024-05-01 Jane joined the card'
+    );
+    expect(
+      summarizeAction(
+        action('removeMemberFromCard', { idMember: 'm2' }, {
+          member: { id: 'm2', fullName: 'Bob', username: 'bob' },
+        })
+      )
+    ).toBe('2024-05-01 Jane removed Bob');
+  });
+
+  it('previews long comments on one line', () => {
+    const summary = summarizeAction(action('commentCard', { text: `line one\n${'x'.repeat(200)}` }));
+
+    expect(summary.startsWith('2024-05-01 Jane commented: "line one xxx')).toBe(true);
+    expect(summary.endsWith('..."')).toBe(true);
+  });
+});
+
+describe('buildListTimeline', () => {
+  const move = (id: string, date: string, from: string, to: string) =>
+    action(
+      'updateCard',
+      { listBefore: { id: from, name: from }, listAfter: { id: to, name: to } },
+      { id, date }
+    );
+
+  it('computes dwell time per stay and per list, oldest first', () => {
+    const created = action(
+      'createCard',
+      { list: { id: 'Todo', name: 'Todo' } },
+      { id: 'a0', date: '2024-05-01T00:00:00.000Z' }
+    );
+    const { timeline, dwellByList } = buildListTimeline(
+      [
+        move('a3', '2024-05-04T00:00:00.000Z', 'Review', 'Done'),
+        move('a2', '2024-05-03T00:00:00.000Z', 'Doing', 'Review'),
+        move('a1', '2024-05-02T12:00:00.000Z', 'Todo', 'Doing'),
+        created,
+      ],
+      new Date('2024-05-05T00:00:00.000Z')
+    );
+
+    expect(timeline.map(stay => [stay.list.name, stay.dwellHours])).toEqual([
+      ['Todo', 36],
+      ['Doing', 12],
+      ['Review', 24],
+      ['Done', 24],
+    ]);
+    expect(timeline[3]).toMatchObject({ leftAt: null, movedBy: 'Jane' });
+    expect(dwellByList[0]).toEqual({ list: { id: 'Todo', name: 'Todo' }, dwellHours: 36 });
+  });
+
+  it('starts from listBefore when creation is outside the fetched actions', () => {
+    const { timeline } = buildListTimeline(
+      [move('a1', '2024-05-02T00:00:00.000Z', 'Todo', 'Doing')],
+      new Date('2024-05-02T06:00:00.000Z')
+    );
+
+    expect(timeline[0]).toEqual({
+      list: { id: 'Todo', name: 'Todo' },
+      enteredAt: null,
+      leftAt: '2024-05-02T00:00:00.000Z',
+      dwellHours: null,
+      movedBy: null,
+    });
+    expect(timeline[1].dwellHours).toBe(6);
+  });
+});
@@ -9,8 +9,10 @@ import {
   attachImageData,
   attachFile,
   getCardAttachments,
+  getAttachmentContent,
   MIME_TYPES,
 } from '../../../src/trello/attachments.js';
+import { Readable } from 'stream';
 
 vi.mock('fs/promises', async () => {
   const actual = await vi.importActual<typeof import('fs/promises')>('fs/promises');
@@ -330,4 +332,79 @@ describe('attachments', () => {
       expect(result).toBe(attachments);
     });
   });
+
+  describe('getAttachmentContent', () => {
+    const params = {
+      cardId: 'c1',
+      attachmentId: 'att1',
+      maxBytes: 10,
+      allowAnyType: false,
+      authorization: 'OAuth test',
+    };
+
+    function mockAttachment(axiosInstance: AxiosInstance, meta: object, body?: Buffer[]) {
+      const get = axiosInstance.get as ReturnType<typeof vi.fn>;
+      get.mockResolvedValueOnce({ data: { isUpload: true, fileName: 'shot.png', ...meta } });
+      if (body) {
+        get.mockResolvedValueOnce({ data: Readable.from(body) });
+      }
+    }
+
+    it('streams an image and returns base64 content', async () => {
+      const axiosInstance = createAxiosMock();
+      mockAttachment(axiosInstance, { mimeType: 'image/png', bytes: 6 }, [
+        Buffer.from('abc'),
+        Buffer.from('def'),
+      ]);
+
+      const result = await getAttachmentContent(axiosInstance, params);
+
+      expect(axiosInstance.get).toHaveBeenLastCalledWith(
+        'https://api.trello.com/1/cards/c1/attachments/att1/download/shot.png',
+        { headers: { Authorization: 'OAuth test' }, responseType: 'stream' }
+      );
+      expect(result).toEqual({
+        data: Buffer.from('abcdef').toString('base64'),
+        mimeType: 'image/png',
+        fileName: 'shot.png',
+        bytes: 6,
+      });
+    });
+
+    it('rejects non-image types unless allowAnyType is set', async () => {
+      const axiosInstance = createAxiosMock();
+      mockAttachment(axiosInstance, { mimeType: 'application/pdf', fileName: 'doc.pdf' });
+
+      await expect(getAttachmentContent(axiosInstance, params)).rejects.toThrow('not an image');
+      expect(axiosInstance.get).toHaveBeenCalledTimes(1);
+    });
+
+    it('rejects when metadata reports a size over the cap', async () => {
+      const axiosInstance = createAxiosMock();
+      mockAttachment(axiosInstance, { mimeType: 'image/png', bytes: 11 });
+
+      await expect(getAttachmentContent(axiosInstance, params)).rejects.toThrow(
+        'over the 10 byte limit'
+      );
+    });
+
+    it('aborts a download that grows past the cap', async () => {
+      const axiosInstance = createAxiosMock();
+      mockAttachment(axiosInstance, { mimeType: 'image/png', bytes: null }, [
+        Buffer.from('123456'),
+        Buffer.from('789012'),
+      ]);
+
+      await expect(getAttachmentContent(axiosInstance, params)).rejects.toThrow(
+        'exceeds the 10 byte limit'
+      );
+    });
+
+    it('rejects link attachments', async () => {
+      const axiosInstance = createAxiosMock();
+      mockAttachment(axiosInstance, { isUpload: false, url: 'https://example.com' });
+
+      await expect(getAttachmentContent(axiosInstance, params)).rejects.toThrow('is a link');
+    });
+  });
 });
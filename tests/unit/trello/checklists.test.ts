@@ -1,6 +1,12 @@
 import { describe, it, expect, vi, beforeEach } from 'vitest';
 import { AxiosInstance } from 'axios';
-import { getCardChecklists } from '../../../src/trello/checklists.js';
+import {
+  buildCheckItemMatcher,
+  filterCheckListItems,
+  getCardChecklists,
+  resolveCheckItem,
+} from '../../../src/trello/checklists.js';
+import { CheckListItem, TrelloChecklist } from '../../../src/types.js';
 
 function createAxiosMock(): AxiosInstance {
   const post = vi.fn().mockResolvedValue({ data: { id: 'a1' } });
@@ -19,7 +25,20 @@ describe('getCardChecklists', () => {
 
     await getCardChecklists(axiosInstance, 'card-123');
 
-    expect(axiosInstance.get).toHaveBeenCalledWith('/cards/card-123/checklists');
+    expect(axiosInstance.get).toHaveBeenCalledWith('/cards/card-123/checklists', {
+      params: { checkItems: 'all' },
+    });
+  });
+
+  it('passes checklist fields through when provided', async () => {
+    const axiosInstance = createAxiosMock();
+    (axiosInstance.get as ReturnType<typeof vi.fn>).mockResolvedValue({ data: [] });
+
+    await getCardChecklists(axiosInstance, 'card-123', { fields: 'name,pos' });
+
+    expect(axiosInstance.get).toHaveBeenCalledWith('/cards/card-123/checklists', {
+      params: { checkItems: 'all', fields: 'name,pos' },
+    });
   });
 
   it('maps each Trello checklist to the CheckList shape', async () => {
@@ -103,3 +122,94 @@ describe('getCardChecklists', () => {
     expect(result[0].percentComplete).toBe(0);
   });
 });
+
+describe('resolveCheckItem', () => {
+  const checklists: TrelloChecklist[] = [
+    {
+      id: 'cl1',
+      name: 'Tasks',
+      idCard: 'card-123',
+      pos: 1,
+      checkItems: [
+        { id: 'i1', name: 'Write docs', state: 'incomplete', pos: 1 },
+        { id: 'i2', name: 'Review', state: 'incomplete', pos: 2 },
+      ],
+    },
+    {
+      id: 'cl2',
+      name: 'Release',
+      idCard: 'card-123',
+      pos: 2,
+      checkItems: [{ id: 'i3', name: 'Review', state: 'complete', pos: 1 }],
+    },
+  ];
+
+  it('finds an item by ID', () => {
+    const result = resolveCheckItem(checklists, { checkItemId: 'i3' });
+
+    expect(result.checklist.id).toBe('cl2');
+    expect(result.checkItem.id).toBe('i3');
+  });
+
+  it('matches item text case-insensitively', () => {
+    const result = resolveCheckItem(checklists, { itemText: 'write DOCS' });
+
+    expect(result.checkItem.id).toBe('i1');
+  });
+
+  it('rejects text that matches items in more than one checklist', () => {
+    expect(() => resolveCheckItem(checklists, { itemText: 'Review' })).toThrow('ambiguous');
+  });
+
+  it('uses checklistName to disambiguate', () => {
+    const result = resolveCheckItem(checklists, { itemText: 'Review', checklistName: 'release' });
+
+    expect(result.checkItem.id).toBe('i3');
+  });
+
+  it('reports a missing checklist or item', () => {
+    expect(() => resolveCheckItem(checklists, { itemText: 'x', checklistName: 'Nope' })).toThrow(
+      'Checklist "Nope" not found on card'
+    );
+    expect(() => resolveCheckItem(checklists, { itemText: 'Nothing' })).toThrow('not found on card');
+  });
+});
+
+describe('filterCheckListItems', () => {
+  const items: CheckListItem[] = [
+    { id: 'i1', text: 'one', complete: true, parentCheckListId: 'cl1' },
+    { id: 'i2', text: 'two', complete: false, parentCheckListId: 'cl1' },
+    { id: 'i3', text: 'three', complete: false, parentCheckListId: 'cl1' },
+  ];
+
+  it('returns only incomplete items with stats for the whole checklist', () => {
+    expect(filterCheckListItems(items, 'incomplete')).toEqual({
+      items: [items[1], items[2]],
+      stats: { total: 3, complete: 1, incomplete: 2, percentComplete: 33 },
+    });
+  });
+
+  it('keeps every item for "all"', () => {
+    expect(filterCheckListItems(items, 'all').items).toEqual(items);
+    expect(filterCheckListItems(items, 'complete').items).toEqual([items[0]]);
+  });
+});
+
+describe('buildCheckItemMatcher', () => {
+  it('matches substrings case-insensitively by default', () => {
+    const matches = buildCheckItemMatcher('Login', false);
+    expect(matches('Fix the login page')).toBe(true);
+    expect(matches('Logout')).toBe(false);
+  });
+
+  it('treats the query as a regular expression when regex is set', () => {
+    const matches = buildCheckItemMatcher('^(fix|add) ', true);
+    expect(matches('Add tests')).toBe(true);
+    expect(matches('Should fix it')).toBe(false);
+  });
+
+  it('rejects invalid and nested-quantifier patterns', () => {
+    expect(() => buildCheckItemMatcher('(unclosed', true)).toThrow('Invalid pattern');
+    expect(() => buildCheckItemMatcher('(a+)+$', true)).toThrow('Nested quantifiers');
+  });
+});
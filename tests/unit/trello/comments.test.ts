@@ -0,0 +1,73 @@
+import { describe, it, expect } from 'vitest';
+import {
+  assertCommentLength,
+  MAX_COMMENT_LENGTH,
+  renderMentions,
+  splitComment,
+} from '../../../src/trello/comments.js';
+import { TrelloMember } from '../../../src/types.js';
+
+const members: TrelloMember[] = [
+  { id: 'm1', username: 'alice', fullName: 'Alice', avatarUrl: null },
+  { id: 'm2', username: 'bob', fullName: 'Bob', avatarUrl: null },
+  { id: 'm3', username: '', fullName: 'No Name', avatarUrl: null },
+];
+
+describe('renderMentions', () => {
+  it('prefixes @username tokens in the requested order', () => {
+    expect(renderMentions('please review', members, ['m2', 'm1'])).toEqual({
+      text: '@bob @alice please review',
+      skipped: [],
+    });
+  });
+
+  it('does not repeat a mention already in the text', () => {
+    expect(renderMentions('thanks @alice!', members, ['m1']).text).toBe('thanks @alice!');
+    expect(renderMentions('cc @alicex', members, ['m1']).text).toBe('@alice cc @alicex');
+  });
+
+  it('skips unknown members and members without usernames', () => {
+    const result = renderMentions('hi', members, ['m3', 'zz', 'm1']);
+
+    expect(result.text).toBe('@alice hi');
+    expect(result.skipped).toEqual([
+      { memberId: 'm3', reason: 'member has no username' },
+      { memberId: 'zz', reason: 'not a member of this board' },
+    ]);
+  });
+});
+
+describe('assertCommentLength', () => {
+  it('accepts comments up to the limit and rejects longer ones', () => {
+    expect(() => assertCommentLength('x'.repeat(MAX_COMMENT_LENGTH))).not.toThrow();
+    expect(() => assertCommentLength('x'.repeat(MAX_COMMENT_LENGTH + 1))).toThrow(
+      'pass autoSplit: true'
+    );
+  });
+});
+
+describe('splitComment', () => {
+  it('returns text that fits as a single unnumbered part', () => {
+    expect(splitComment('short note', 100)).toEqual(['short note']);
+  });
+
+  it('numbers the parts and breaks on paragraph boundaries', () => {
+    const text = ['a'.repeat(50), 'b'.repeat(50), 'c'.repeat(50)].join('\n\n');
+    expect(splitComment(text, 140)).toEqual([
+      `(1/2) ${'a'.repeat(50)}\n\n${'b'.repeat(50)}`,
+      `(2/2) ${'c'.repeat(50)}`,
+    ]);
+  });
+
+  it('closes and reopens a code block that spans parts', () => {
+    const code = Array.from({ length: 12 }, (_, i) => `line ${i} of the script`).join('\n');
+    const parts = splitComment(`\`\`\`\n${code}\n\`\`\``, 200);
+
+    expect(parts.length).toBeGreaterThan(1);
+    expect(parts[1].startsWith(`(2/${parts.length})\n\`\`\`\n`)).toBe(true);
+    for (const part of parts) {
+      expect(part.length).toBeLessThanOrEqual(200);
+      expect((part.match(/^```/gm) ?? []).length % 2).toBe(0);
+    }
+  });
+});
@@ -0,0 +1,73 @@
+import { describe, it, expect } from 'vitest';
+import { decodeCustomFieldItems } from '../../../src/trello/custom-fields.js';
+import { TrelloCustomFieldDefinition, TrelloCustomFieldItem } from '../../../src/types.js';
+
+function def(
+  id: string,
+  name: string,
+  type: TrelloCustomFieldDefinition['type'],
+  extra: Partial<TrelloCustomFieldDefinition> = {}
+): TrelloCustomFieldDefinition {
+  return {
+    id,
+    idModel: 'b1',
+    modelType: 'board',
+    fieldGroup: 'g',
+    name,
+    type,
+    pos: 1,
+    display: { cardFront: true },
+    ...extra,
+  };
+}
+
+function item(idCustomField: string, extra: Partial<TrelloCustomFieldItem>): TrelloCustomFieldItem {
+  return { id: `i-${idCustomField}`, idCustomField, idModel: 'c1', modelType: 'card', ...extra };
+}
+
+const definitions = [
+  def('f1', 'Priority', 'list', {
+    options: [
+      { id: 'o1', idCustomField: 'f1', value: { text: 'High' }, color: 'red', pos: 1 },
+      { id: 'o2', idCustomField: 'f1', value: { text: 'Low' }, color: 'green', pos: 2 },
+    ],
+  }),
+  def('f2', 'Estimate', 'number'),
+  def('f3', 'Blocked', 'checkbox'),
+  def('f4', 'Launch', 'date'),
+  def('f5', 'Owner', 'text'),
+];
+
+describe('decodeCustomFieldItems', () => {
+  it('decodes each field type into a friendly value', () => {
+    const decoded = decodeCustomFieldItems(
+      [
+        item('f1', { idValue: 'o1' }),
+        item('f2', { value: { number: '3.5' } }),
+        item('f3', { value: { checked: 'true' } }),
+        item('f4', { value: { date: '2024-06-01T09:00:00Z' } }),
+        item('f5', { value: { text: 'Jane' } }),
+      ],
+      definitions
+    );
+
+    expect(decoded).toEqual([
+      { id: 'f1', name: 'Priority', type: 'list', value: 'High' },
+      { id: 'f2', name: 'Estimate', type: 'number', value: 3.5 },
+      { id: 'f3', name: 'Blocked', type: 'checkbox', value: true },
+      { id: 'f4', name: 'Launch', type: 'date', value: '2024-06-01T09:00:00.000Z' },
+      { id: 'f5', name: 'Owner', type: 'text', value: 'Jane' },
+    ]);
+  });
+
+  it('falls back to the raw option id when the option is unknown', () => {
+    const decoded = decodeCustomFieldItems([item('f1', { idValue: 'gone' })], definitions);
+    expect(decoded[0].value).toBe('gone');
+  });
+
+  it('drops items whose field definition no longer exists', () => {
+    expect(decodeCustomFieldItems([item('deleted', { value: { text: 'x' } })], definitions)).toEqual(
+      []
+    );
+  });
+});
@@ -0,0 +1,19 @@
+import { describe, it, expect } from 'vitest';
+import { parseDefaultLists } from '../../../src/trello/default-lists.js';
+
+describe('parseDefaultLists', () => {
+  it('accepts board IDs mapped to list IDs', () => {
+    expect(parseDefaultLists({ b1: 'l1', b2: 'l2' })).toEqual({ b1: 'l1', b2: 'l2' });
+  });
+
+  it('rejects list IDs that are not non-empty strings', () => {
+    expect(() => parseDefaultLists({ b1: 42 })).toThrow(
+      'defaultLists."b1" must be a list ID string'
+    );
+    expect(() => parseDefaultLists({ b1: '' })).toThrow('must be a list ID string');
+  });
+
+  it('rejects a non-object section', () => {
+    expect(() => parseDefaultLists(['l1'])).toThrow('defaultLists must be an object');
+  });
+});
@@ -0,0 +1,75 @@
+import { describe, it, expect } from 'vitest';
+import { buildDueReport } from '../../../src/trello/due-report.js';
+
+const card = (id: string, due: string | null, dueComplete = false) => ({
+  id,
+  name: `Card ${id}`,
+  due,
+  dueComplete,
+  idList: 'l1',
+  url: `https://trello.com/c/${id}`,
+});
+
+describe('buildDueReport', () => {
+  const lists = new Map([['l1', 'Doing']]);
+  const now = new Date('2024-05-06T15:00:00.000Z');
+
+  it('buckets cards relative to now and skips completed ones', () => {
+    const report = buildDueReport(
+      [
+        card('late', '2024-05-06T09:00:00.000Z'),
+        card('today', '2024-05-06T20:00:00.000Z'),
+        card('week', '2024-05-12T12:00:00.000Z'),
+        card('later', '2024-05-13T12:00:00.000Z'),
+        card('none', null),
+        card('done', '2024-05-01T00:00:00.000Z', true),
+      ],
+      lists,
+      'UTC',
+      now
+    );
+
+    const ids = Object.fromEntries(
+      Object.entries(report.buckets).map(([bucket, { cards }]) => [bucket, cards.map(c => c.id)])
+    );
+    expect(ids).toEqual({
+      overdue: ['late'],
+      dueToday: ['today'],
+      dueThisWeek: ['week'],
+      later: ['later'],
+      noDueDate: ['none'],
+    });
+    expect(report.buckets.overdue).toMatchObject({ count: 1, cards: [{ list: 'Doing' }] });
+  });
+
+  it('uses the requested timezone to decide what is due today', () => {
+    // 02:00 UTC on the 7th is still the 6th in New York
+    const report = buildDueReport(
+      [card('evening', '2024-05-07T02:00:00.000Z')],
+      lists,
+      'America/New_York',
+      now
+    );
+    expect(report.buckets.dueToday.count).toBe(1);
+  });
+
+  it('rejects an unknown timezone', () => {
+    expect(() => buildDueReport([], lists, 'Mars/Olympus', now)).toThrow('Unknown timezone');
+  });
+
+  it('includes the planned start date of each card', () => {
+    const report = buildDueReport(
+      [
+        { ...card('boxed', '2024-05-08T12:00:00.000Z'), start: '2024-05-07T00:00:00.000Z' },
+        card('open', '2024-05-09T12:00:00.000Z'),
+      ],
+      lists,
+      'UTC',
+      now
+    );
+    expect(report.buckets.dueThisWeek.cards.map(c => c.start)).toEqual([
+      '2024-05-07T00:00:00.000Z',
+      null,
+    ]);
+  });
+});
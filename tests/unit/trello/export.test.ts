@@ -0,0 +1,119 @@
+import { describe, it, expect } from 'vitest';
+import {
+  buildBoardExport,
+  isWithinDirectory,
+  RawBoardPayload,
+} from '../../../src/trello/export.js';
+
+function payload(): RawBoardPayload {
+  return {
+    id: 'b1',
+    name: 'Board',
+    labels: [
+      { id: 'l2', name: 'Bug', color: 'red' },
+      { id: 'l1', name: 'Feature', color: 'green' },
+    ],
+    members: [
+      { id: 'm2', username: 'zed' },
+      { id: 'm1', username: 'amy', fullName: 'Amy' },
+    ],
+    lists: [
+      { id: 'list-b', name: 'Done', pos: 200 },
+      { id: 'list-a', name: 'Todo', pos: 100 },
+    ],
+    cards: [
+      { id: 'c3', idList: 'list-b', name: 'Shipped', pos: 1 },
+      { id: 'c2', idList: 'list-a', name: 'Second', pos: 20, idLabels: ['l2', 'l1'] },
+      { id: 'c1', idList: 'list-a', name: 'First', pos: 10 },
+    ],
+    checklists: [
+      {
+        id: 'ck2',
+        idCard: 'c1',
+        name: 'Later',
+        pos: 2,
+        checkItems: [],
+      },
+      {
+        id: 'ck1',
+        idCard: 'c1',
+        name: 'Steps',
+        pos: 1,
+        checkItems: [
+          { id: 'i2', name: 'two', state: 'incomplete', pos: 2 },
+          { id: 'i1', name: 'one', state: 'complete', pos: 1 },
+        ],
+      },
+    ],
+    actions: [
+      {
+        id: 'a2',
+        type: 'commentCard',
+        date: '2024-01-02T00:00:00.000Z',
+        data: { text: 'second', card: { id: 'c1' } },
+        memberCreator: { username: 'amy' },
+      },
+      {
+        id: 'a1',
+        type: 'commentCard',
+        date: '2024-01-01T00:00:00.000Z',
+        data: { text: 'first', card: { id: 'c1' } },
+        memberCreator: { username: 'zed' },
+      },
+    ],
+  };
+}
+
+describe('buildBoardExport', () => {
+  it('orders lists, cards, checklists, and items by position', () => {
+    const doc = buildBoardExport(payload(), { includeComments: false });
+    expect(doc.lists.map(list => list.id)).toEqual(['list-a', 'list-b']);
+    expect(doc.cards.map(card => card.id)).toEqual(['c1', 'c2', 'c3']);
+    expect(doc.cards[0].checklists.map(checklist => checklist.id)).toEqual(['ck1', 'ck2']);
+    expect(doc.cards[0].checklists[0].items.map(item => item.id)).toEqual(['i1', 'i2']);
+  });
+
+  it('sorts labels, members, and card label IDs by ID', () => {
+    const doc = buildBoardExport(payload(), { includeComments: false });
+    expect(doc.labels.map(label => label.id)).toEqual(['l1', 'l2']);
+    expect(doc.members.map(member => member.id)).toEqual(['m1', 'm2']);
+    expect(doc.cards[1].idLabels).toEqual(['l1', 'l2']);
+  });
+
+  it('produces identical JSON regardless of input order', () => {
+    const reversed = payload();
+    reversed.lists!.reverse();
+    reversed.cards!.reverse();
+    reversed.labels!.reverse();
+    reversed.checklists!.reverse();
+    reversed.actions!.reverse();
+    expect(JSON.stringify(buildBoardExport(reversed, { includeComments: true }))).toBe(
+      JSON.stringify(buildBoardExport(payload(), { includeComments: true }))
+    );
+  });
+
+  it('omits comments unless requested and orders them by date when included', () => {
+    expect(buildBoardExport(payload(), { includeComments: false }).cards[0]).not.toHaveProperty(
+      'comments'
+    );
+    const doc = buildBoardExport(payload(), { includeComments: true });
+    expect(doc.cards[0].comments).toEqual([
+      { id: 'a1', date: '2024-01-01T00:00:00.000Z', author: 'zed', text: 'first' },
+      { id: 'a2', date: '2024-01-02T00:00:00.000Z', author: 'amy', text: 'second' },
+    ]);
+    expect(doc.cards[1].comments).toEqual([]);
+  });
+});
+
+describe('isWithinDirectory', () => {
+  it('accepts the directory and paths below it', () => {
+    expect(isWithinDirectory('/data/exports', '/data/exports/board.json')).toBe(true);
+    expect(isWithinDirectory('/data/exports', '/data/exports/nested/..board.json')).toBe(true);
+  });
+
+  it('rejects paths outside the directory', () => {
+    expect(isWithinDirectory('/data/exports', '/etc/passwd')).toBe(false);
+    expect(isWithinDirectory('/data/exports', '/data/exports-old/board.json')).toBe(false);
+    expect(isWithinDirectory('/data/exports', '/data')).toBe(false);
+  });
+});
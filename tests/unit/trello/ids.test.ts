@@ -0,0 +1,40 @@
+import { describe, it, expect } from 'vitest';
+import { assertWellFormedIds, isTrelloId } from '../../../src/trello/ids.js';
+
+const ID = '5f1b2c3d4e5f6a7b8c9d0e1f';
+
+describe('isTrelloId', () => {
+  it('accepts 24 hex characters and, when allowed, board short links', () => {
+    expect(isTrelloId(ID)).toBe(true);
+    expect(isTrelloId('aBcD1234')).toBe(false);
+    expect(isTrelloId('aBcD1234', { allowShortLink: true })).toBe(true);
+  });
+
+  it('rejects ids of the wrong length or alphabet', () => {
+    expect(isTrelloId(ID.slice(1))).toBe(false);
+    expect(isTrelloId(`${ID.slice(1)}z`)).toBe(false);
+    expect(isTrelloId('my-board', { allowShortLink: true })).toBe(false);
+  });
+});
+
+describe('assertWellFormedIds', () => {
+  it('passes well-formed board and list paths and paths without ids', () => {
+    expect(() => assertWellFormedIds(`/boards/${ID}/lists`)).not.toThrow();
+    expect(() => assertWellFormedIds('/boards/aBcD1234')).not.toThrow();
+    expect(() => assertWellFormedIds(`/lists/${ID}/cards?fields=id`)).not.toThrow();
+    expect(() => assertWellFormedIds('/boards')).not.toThrow();
+    expect(() => assertWellFormedIds('/members/me/boards')).not.toThrow();
+  });
+
+  it('rejects a malformed board id before any request is made', () => {
+    expect(() => assertWellFormedIds('/boards/Sprint Board/cards')).toThrow(
+      `Board id "Sprint Board": that doesn't look like a valid Trello id`
+    );
+  });
+
+  it('rejects a list short link, which Trello does not support', () => {
+    expect(() => assertWellFormedIds('/lists/aBcD1234')).toThrow(
+      `List id "aBcD1234": that doesn't look like a valid Trello id (expected 24 hex characters).`
+    );
+  });
+});
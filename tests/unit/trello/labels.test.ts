@@ -0,0 +1,42 @@
+import { describe, it, expect } from 'vitest';
+import { formatCardLabels, formatLabel } from '../../../src/trello/labels.js';
+
+describe('formatLabel', () => {
+  const bug = { id: 'l1', name: 'Bug', color: 'red' };
+  const unnamed = { id: 'l2', name: '', color: 'green' };
+  const colorless = { id: 'l3', name: 'Later', color: null };
+
+  it('renders bare colors', () => {
+    expect([bug, unnamed, colorless].map(label => formatLabel(label, 'color'))).toEqual([
+      'red',
+      'green',
+      'none',
+    ]);
+  });
+
+  it('renders color:name and drops empty names', () => {
+    expect([bug, unnamed, colorless].map(label => formatLabel(label, 'color:name'))).toEqual([
+      'red:Bug',
+      'green',
+      'none:Later',
+    ]);
+  });
+
+  it('keeps full objects with a normalized name', () => {
+    expect(formatLabel({ id: 'l4', color: 'blue' }, 'full')).toEqual({
+      id: 'l4',
+      name: '',
+      color: 'blue',
+    });
+  });
+});
+
+describe('formatCardLabels', () => {
+  it('formats expanded labels and leaves cards without them untouched', () => {
+    const card = { id: 'c1', labels: [{ id: 'l1', name: 'Bug', color: 'red' }] };
+    expect(formatCardLabels(card, 'color:name')).toEqual({ id: 'c1', labels: ['red:Bug'] });
+
+    const bare = { id: 'c2', idLabels: ['l1'] };
+    expect(formatCardLabels(bare, 'color')).toBe(bare);
+  });
+});
@@ -0,0 +1,30 @@
+import { describe, it, expect } from 'vitest';
+import { parseCardShortLink } from '../../../src/trello/links.js';
+
+describe('parseCardShortLink', () => {
+  it('returns a bare short link unchanged', () => {
+    expect(parseCardShortLink('AbCdEf12')).toBe('AbCdEf12');
+    expect(parseCardShortLink('  AbCdEf12 ')).toBe('AbCdEf12');
+  });
+
+  it('accepts a full card ID', () => {
+    expect(parseCardShortLink('5f1e2d3c4b5a69788796a5b4')).toBe('5f1e2d3c4b5a69788796a5b4');
+  });
+
+  it('extracts the code from card URLs with slugs, queries, and fragments', () => {
+    expect(parseCardShortLink('https://trello.com/c/AbCdEf12')).toBe('AbCdEf12');
+    expect(parseCardShortLink('https://trello.com/c/AbCdEf12/')).toBe('AbCdEf12');
+    expect(parseCardShortLink('https://trello.com/c/AbCdEf12/42-fix-the-thing')).toBe('AbCdEf12');
+    expect(parseCardShortLink('https://trello.com/c/AbCdEf12/42-slug?filter=me#comment-1')).toBe(
+      'AbCdEf12'
+    );
+    expect(parseCardShortLink('trello.com/c/AbCdEf12?x=1')).toBe('AbCdEf12');
+  });
+
+  it('rejects board links and garbage', () => {
+    expect(() => parseCardShortLink('https://trello.com/b/AbCdEf12/board')).toThrow(
+      'Could not find a Trello card short link'
+    );
+    expect(() => parseCardShortLink('not a link')).toThrow('Could not find a Trello card short link');
+  });
+});
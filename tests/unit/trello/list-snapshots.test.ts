@@ -0,0 +1,40 @@
+import { describe, it, expect } from 'vitest';
+import { diffListSnapshot, takeListSnapshot } from '../../../src/trello/list-snapshots.js';
+
+const card = (id: string, dateLastActivity: string, pos: number) => ({
+  id,
+  name: `Card ${id}`,
+  dateLastActivity,
+  pos,
+});
+
+describe('diffListSnapshot', () => {
+  it('classifies added, removed, updated, and reordered cards', () => {
+    const snapshot = takeListSnapshot(
+      'l1',
+      [card('a', 'd1', 1), card('b', 'd1', 2), card('c', 'd1', 3)],
+      new Date('2024-05-01T00:00:00.000Z')
+    );
+
+    const diff = diffListSnapshot(snapshot, [
+      card('b', 'd2', 2),
+      card('c', 'd1', 0.5),
+      card('d', 'd1', 4),
+    ]);
+
+    expect(diff).toEqual({
+      listId: 'l1',
+      since: '2024-05-01T00:00:00.000Z',
+      added: [{ id: 'd', name: 'Card d' }],
+      removed: [{ id: 'a', name: 'Card a' }],
+      updated: [{ id: 'b', name: 'Card b', dateLastActivity: 'd2' }],
+      reordered: [{ id: 'c', name: 'Card c' }],
+    });
+  });
+
+  it('reports nothing for an unchanged list', () => {
+    const cards = [card('a', 'd1', 1)];
+    const diff = diffListSnapshot(takeListSnapshot('l1', cards), cards);
+    expect([diff.added, diff.removed, diff.updated, diff.reordered]).toEqual([[], [], [], []]);
+  });
+});
@@ -0,0 +1,47 @@
+import { describe, it, expect } from 'vitest';
+import { filterByName, resolveByName } from '../../../src/trello/name-matching.js';
+
+const boards = [
+  { id: 'b1', name: 'Sprint Planning' },
+  { id: 'b2', name: 'sprint planning' },
+  { id: 'b3', name: 'Q3 Road-map' },
+];
+const names = (board: { name: string }) => [board.name];
+
+describe('filterByName', () => {
+  it('compares case-sensitively in exact mode', () => {
+    expect(filterByName(boards, 'Sprint Planning', names, 'exact').map(b => b.id)).toEqual([
+      'b1',
+    ]);
+  });
+
+  it('ignores case and surrounding whitespace in ci mode', () => {
+    expect(filterByName(boards, ' SPRINT planning ', names, 'ci').map(b => b.id)).toEqual([
+      'b1',
+      'b2',
+    ]);
+    expect(filterByName(boards, 'road map', names, 'ci')).toEqual([]);
+  });
+
+  it('falls back to punctuation-insensitive partial matches in fuzzy mode', () => {
+    expect(filterByName(boards, 'road map', names, 'fuzzy').map(b => b.id)).toEqual(['b3']);
+    expect(filterByName(boards, 'sprint planning', names, 'fuzzy')).toHaveLength(2);
+  });
+});
+
+describe('resolveByName', () => {
+  const options = { kind: 'Board', names, idParam: 'boardId', notFoundHint: 'Use list_boards.' };
+
+  it('returns the single match', () => {
+    expect(resolveByName(boards, 'q3 road-map', options).id).toBe('b3');
+  });
+
+  it('reports missing and ambiguous names in the shared shape', () => {
+    expect(() => resolveByName(boards, 'Nope', options)).toThrow(
+      'Board "Nope" not found. Use list_boards.'
+    );
+    expect(() => resolveByName(boards, 'sprint planning', options)).toThrow(
+      'Board name "sprint planning" is ambiguous: Sprint Planning (b1), sprint planning (b2). Pass boardId instead.'
+    );
+  });
+});
@@ -0,0 +1,38 @@
+import { describe, it, expect } from 'vitest';
+import { summarizeNotification } from '../../../src/trello/notifications.js';
+
+describe('summarizeNotification', () => {
+  it('keeps the card and board the notification is about', () => {
+    expect(
+      summarizeNotification({
+        id: 'n1',
+        type: 'mentionedOnCard',
+        date: '2024-05-06T10:00:00.000Z',
+        unread: true,
+        data: {
+          card: { id: 'c1', name: 'Fix login' },
+          board: { id: 'b1', name: 'Dev' },
+          text: '@amy can you look?',
+        },
+      })
+    ).toEqual({
+      id: 'n1',
+      type: 'mentionedOnCard',
+      date: '2024-05-06T10:00:00.000Z',
+      card: { id: 'c1', name: 'Fix login' },
+      board: { id: 'b1', name: 'Dev' },
+      unread: true,
+    });
+  });
+
+  it('uses null when there is no card or board', () => {
+    const summary = summarizeNotification({
+      id: 'n2',
+      type: 'addedToOrganization',
+      date: '2024-05-06T10:00:00.000Z',
+      unread: false,
+    });
+    expect(summary.card).toBeNull();
+    expect(summary.board).toBeNull();
+  });
+});
@@ -0,0 +1,65 @@
+import { describe, it, expect } from 'vitest';
+import { positionRelativeTo, sortCards, POSITION_STEP } from '../../../src/trello/positions.js';
+
+describe('positionRelativeTo', () => {
+  const items = [
+    { id: 'b', pos: 2048 },
+    { id: 'a', pos: 1024 },
+    { id: 'c', pos: 4096 },
+  ];
+
+  it('places between the reference and its upper neighbor', () => {
+    expect(positionRelativeTo(items, 'b', 'above')).toBe(1536);
+  });
+
+  it('places between the reference and its lower neighbor', () => {
+    expect(positionRelativeTo(items, 'b', 'below')).toBe(3072);
+  });
+
+  it('handles the first and last items', () => {
+    expect(positionRelativeTo(items, 'a', 'above')).toBe(512);
+    expect(positionRelativeTo(items, 'c', 'below')).toBe(4096 + POSITION_STEP);
+  });
+
+  it('rejects an unknown reference', () => {
+    expect(() => positionRelativeTo(items, 'z', 'above')).toThrow('Reference item z not found');
+  });
+});
+
+describe('sortCards', () => {
+  const card = (name: string, due: string | null, dateLastActivity: string, pos: number) => ({
+    name,
+    due,
+    dateLastActivity,
+    pos,
+  });
+  const cards = [
+    card('beta', '2024-03-01T00:00:00Z', '2024-01-03T00:00:00Z', 1),
+    card('Alpha', null, '2024-01-01T00:00:00Z', 2),
+    card('gamma', '2024-02-01T00:00:00Z', '2024-01-02T00:00:00Z', 3),
+  ];
+  const names = (sorted: typeof cards) => sorted.map(c => c.name);
+
+  it('sorts by due date with undated cards last in both directions', () => {
+    expect(names(sortCards(cards, 'due', 'asc'))).toEqual(['gamma', 'beta', 'Alpha']);
+    expect(names(sortCards(cards, 'due', 'desc'))).toEqual(['beta', 'gamma', 'Alpha']);
+  });
+
+  it('sorts by name case-insensitively', () => {
+    expect(names(sortCards(cards, 'name', 'asc'))).toEqual(['Alpha', 'beta', 'gamma']);
+  });
+
+  it('sorts by position in either direction', () => {
+    expect(names(sortCards(cards, 'pos', 'asc'))).toEqual(['beta', 'Alpha', 'gamma']);
+    expect(names(sortCards(cards, 'pos', 'desc'))).toEqual(['gamma', 'Alpha', 'beta']);
+  });
+
+  it('leaves the input untouched', () => {
+    sortCards(cards, 'name', 'asc');
+    expect(names(cards)).toEqual(['beta', 'Alpha', 'gamma']);
+  });
+
+  it('sorts by last activity', () => {
+    expect(names(sortCards(cards, 'dateLastActivity', 'desc'))).toEqual(['beta', 'gamma', 'Alpha']);
+  });
+});
@@ -0,0 +1,17 @@
+import { describe, it, expect } from 'vitest';
+import { resolvePowerUpId, WELL_KNOWN_POWER_UPS } from '../../../src/trello/power-ups.js';
+
+describe('resolvePowerUpId', () => {
+  it('maps well-known names case-insensitively', () => {
+    expect(resolvePowerUpId('Custom-Fields')).toBe(WELL_KNOWN_POWER_UPS['custom-fields']);
+    expect(resolvePowerUpId('voting')).toBe(WELL_KNOWN_POWER_UPS.voting);
+  });
+
+  it('passes plugin IDs through', () => {
+    expect(resolvePowerUpId('0123456789abcdef01234567')).toBe('0123456789abcdef01234567');
+  });
+
+  it('rejects unknown names', () => {
+    expect(() => resolvePowerUpId('butler')).toThrow('Unknown Power-Up "butler"');
+  });
+});
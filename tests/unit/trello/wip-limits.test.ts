@@ -0,0 +1,31 @@
+import { describe, it, expect } from 'vitest';
+import { findWipLimit, parseWipLimits } from '../../../src/trello/wip-limits.js';
+
+describe('parseWipLimits', () => {
+  it('accepts non-negative integer limits', () => {
+    expect(parseWipLimits({ Doing: 3, Blocked: 0 })).toEqual({ Doing: 3, Blocked: 0 });
+  });
+
+  it('rejects limits that are not non-negative integers', () => {
+    expect(() => parseWipLimits({ Doing: '3' })).toThrow(
+      'wipLimits."Doing" must be a non-negative integer'
+    );
+    expect(() => parseWipLimits({ Doing: 1.5 })).toThrow('must be a non-negative integer');
+  });
+
+  it('rejects a non-object section', () => {
+    expect(() => parseWipLimits([3])).toThrow('wipLimits must be an object');
+  });
+});
+
+describe('findWipLimit', () => {
+  it('matches list names per the name matching mode', () => {
+    expect(findWipLimit({ Doing: 3 }, 'doing')).toBe(3);
+    expect(findWipLimit({ Doing: 3 }, 'doing', 'exact')).toBeUndefined();
+    expect(findWipLimit({ Doing: 3 }, 'Done')).toBeUndefined();
+  });
+
+  it('applies the strictest limit when several names match', () => {
+    expect(findWipLimit({ Doing: 3, doing: 2 }, 'DOING')).toBe(2);
+  });
+});
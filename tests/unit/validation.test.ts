@@ -0,0 +1,122 @@
+import { describe, it, expect } from 'vitest';
+import { z } from 'zod/v4';
+import { McpError, ErrorCode } from '@modelcontextprotocol/sdk/types.js';
+import { McpServer } from '@modelcontextprotocol/sdk/server/mcp.js';
+import { Client } from '@modelcontextprotocol/sdk/client/index.js';
+import { InMemoryTransport } from '@modelcontextprotocol/sdk/inMemory.js';
+import {
+  formatIssuePath,
+  formatZodIssues,
+  installValidationErrorFormatter,
+} from '../../src/validation.js';
+
+const schema = z.object({
+  cardId: z.string(),
+  memberIds: z.array(z.string()).optional(),
+  checklists: z.array(z.object({ name: z.string() })).optional(),
+  state: z.enum(['complete', 'incomplete']).optional(),
+});
+
+function issuesFor(input: unknown) {
+  const result = schema.safeParse(input);
+  if (result.success) throw new Error('expected a validation failure');
+  return result.error.issues;
+}
+
+describe('formatIssuePath', () => {
+  it('renders keys and array indexes', () => {
+    expect(formatIssuePath(['checklists', 0, 'name'])).toBe('checklists[0].name');
+    expect(formatIssuePath([])).toBe('arguments');
+  });
+});
+
+describe('formatZodIssues', () => {
+  it('reports the element of an array with the wrong type', () => {
+    expect(formatZodIssues(issuesFor({ cardId: 'c1', memberIds: ['m1', 2] }))).toBe(
+      'memberIds[1]: expected string'
+    );
+  });
+
+  it('reports nested object fields inside arrays', () => {
+    expect(formatZodIssues(issuesFor({ cardId: 'c1', checklists: [{ name: 'ok' }, {}] }))).toBe(
+      'checklists[1].name: expected string'
+    );
+  });
+
+  it('lists the allowed enum values', () => {
+    expect(formatZodIssues(issuesFor({ cardId: 'c1', state: 'done' }))).toBe(
+      'state: expected one of "complete", "incomplete"'
+    );
+  });
+
+  it('reports every issue on its own line', () => {
+    expect(formatZodIssues(issuesFor({ state: 'done' })).split('\n')).toEqual([
+      'cardId: expected string',
+      'state: expected one of "complete", "incomplete"',
+    ]);
+  });
+});
+
+describe('installValidationErrorFormatter', () => {
+  function fakeServer() {
+    return {
+      validateToolInput: async (tool: { inputSchema: typeof schema }, args: unknown, name: string) => {
+        const result = await tool.inputSchema.safeParseAsync(args);
+        if (!result.success) {
+          throw new McpError(ErrorCode.InvalidParams, `Invalid arguments for tool ${name}: raw`);
+        }
+        return result.data;
+      },
+    };
+  }
+
+  it('replaces the raw error with path-qualified issues', async () => {
+    const server = fakeServer();
+    installValidationErrorFormatter(server as unknown as McpServer);
+
+    await expect(
+      server.validateToolInput({ inputSchema: schema }, { cardId: 'c1', memberIds: [1] }, 'set_card_members')
+    ).rejects.toThrow('Invalid arguments for tool set_card_members:\nmemberIds[0]: expected string');
+  });
+
+  it('passes valid input through', async () => {
+    const server = fakeServer();
+    installValidationErrorFormatter(server as unknown as McpServer);
+
+    await expect(
+      server.validateToolInput({ inputSchema: schema }, { cardId: 'c1' }, 'get_card')
+    ).resolves.toEqual({ cardId: 'c1' });
+  });
+
+  // The formatter patches a private SDK method; these fail if an SDK upgrade drops it
+  it('targets a method the installed SDK still has', () => {
+    expect(typeof (McpServer.prototype as unknown as Record<string, unknown>).validateToolInput).toBe(
+      'function'
+    );
+  });
+
+  it('formats bad arguments sent to a real McpServer', async () => {
+    const server = new McpServer({ name: 'test', version: '0.0.0' });
+    server.registerTool(
+      'set_card_members',
+      { inputSchema: { cardId: z.string(), memberIds: z.array(z.string()) } },
+      async () => ({ content: [] })
+    );
+    installValidationErrorFormatter(server);
+    const [clientTransport, serverTransport] = InMemoryTransport.createLinkedPair();
+    const client = new Client({ name: 'test-client', version: '0.0.0' });
+    await Promise.all([server.connect(serverTransport), client.connect(clientTransport)]);
+
+    const message = await client
+      .callTool({ name: 'set_card_members', arguments: { cardId: 'c1', memberIds: [1] } })
+      .then(
+        result => (result.content as Array<{ text: string }>)[0].text,
+        (error: Error) => error.message
+      );
+
+    expect(message).toContain(
+      'Invalid arguments for tool set_card_members:\nmemberIds[0]: expected string'
+    );
+    await client.close();
+  });
+});